@@ -118,14 +118,17 @@ type (
 		Driver        Driver
 		Linker        Linker
 		Contents      ContentService
+		Deployments   DeploymentService
 		Git           GitService
 		Organizations OrganizationService
 		Issues        IssueService
+		Labels        LabelService
 		Milestones    MilestoneService
 		PullRequests  PullRequestService
 		Repositories  RepositoryService
 		Releases      ReleaseService
 		Reviews       ReviewService
+		Search        SearchService
 		Users         UserService
 		Webhooks      WebhookService
 
@@ -134,6 +137,11 @@ type (
 		// This can be set to httputil.DumpResponse.
 		DumpResponse func(*http.Response, bool) ([]byte, error)
 
+		// RawWebhooks optionally configures the webhook
+		// parser to return a RawHook for unrecognized
+		// webhook events, instead of ErrUnknownEvent.
+		RawWebhooks bool
+
 		// snapshot of the request rate limit.
 		rate Rate
 	}
@@ -5,6 +5,7 @@
 package scm
 
 import (
+	"encoding"
 	"encoding/json"
 )
 
@@ -22,6 +23,50 @@ const (
 	StateError
 )
 
+// String returns the string representation of State.
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateRunning:
+		return "running"
+	case StateSuccess:
+		return "success"
+	case StateFailure:
+		return "failure"
+	case StateCanceled:
+		return "canceled"
+	case StateError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText returns the text-encoded State.
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded State. Unknown
+// values are decoded as StateUnknown.
+func (s *State) UnmarshalText(text []byte) error {
+	for x := StateUnknown; x <= StateError; x++ {
+		if x.String() == string(text) {
+			*s = x
+			return nil
+		}
+	}
+	*s = StateUnknown
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded State. It also
+// accepts the legacy integer encoding.
+func (s *State) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, s, func(i int) { *s = State(i) })
+}
+
 // Action identifies webhook actions.
 type Action int
 
@@ -48,6 +93,17 @@ const (
 	ActionUnpublish
 	ActionPrerelease
 	ActionRelease
+	// reviews
+	ActionSubmit
+	ActionDismiss
+	// checks and workflows
+	ActionRequest
+	ActionRerequest
+	ActionStart
+	ActionComplete
+	// assignees
+	ActionAssign
+	ActionUnassign
 )
 
 // String returns the string representation of Action.
@@ -73,6 +129,8 @@ func (a Action) String() (s string) {
 		return "synchronized"
 	case ActionMerge:
 		return "merged"
+	case ActionEdit:
+		return "edited"
 	case ActionPublish:
 		return "published"
 	case ActionUnpublish:
@@ -83,11 +141,45 @@ func (a Action) String() (s string) {
 		return "released"
 	case ActionReviewReady:
 		return "review_ready"
+	case ActionSubmit:
+		return "submitted"
+	case ActionDismiss:
+		return "dismissed"
+	case ActionRequest:
+		return "requested"
+	case ActionRerequest:
+		return "rerequested"
+	case ActionStart:
+		return "started"
+	case ActionComplete:
+		return "completed"
+	case ActionAssign:
+		return "assigned"
+	case ActionUnassign:
+		return "unassigned"
 	default:
 		return
 	}
 }
 
+// MarshalText returns the text-encoded Action.
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded Action. Unknown
+// values are decoded as ActionUnknown.
+func (a *Action) UnmarshalText(text []byte) error {
+	for x := ActionUnknown; x <= ActionUnassign; x++ {
+		if x.String() == string(text) {
+			*a = x
+			return nil
+		}
+	}
+	*a = ActionUnknown
+	return nil
+}
+
 // MarshalJSON returns the JSON-encoded Action.
 func (a Action) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a.String())
@@ -99,41 +191,7 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
-	switch s {
-	case "created":
-		*a = ActionCreate
-	case "updated":
-		*a = ActionUpdate
-	case "deleted":
-		*a = ActionDelete
-	case "labeled":
-		*a = ActionLabel
-	case "unlabeled":
-		*a = ActionUnlabel
-	case "opened":
-		*a = ActionOpen
-	case "reopened":
-		*a = ActionReopen
-	case "closed":
-		*a = ActionClose
-	case "synchronized":
-		*a = ActionSync
-	case "merged":
-		*a = ActionMerge
-	case "edited":
-		*a = ActionEdit
-	case "published":
-		*a = ActionPublish
-	case "unpublished":
-		*a = ActionUnpublish
-	case "prereleased":
-		*a = ActionPrerelease
-	case "released":
-		*a = ActionRelease
-	case "review_ready":
-		*a = ActionReviewReady
-	}
-	return nil
+	return a.UnmarshalText([]byte(s))
 }
 
 // Driver identifies source code management driver.
@@ -182,6 +240,30 @@ func (d Driver) String() (s string) {
 	}
 }
 
+// MarshalText returns the text-encoded Driver.
+func (d Driver) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded Driver. Unknown
+// values are decoded as DriverUnknown.
+func (d *Driver) UnmarshalText(text []byte) error {
+	for x := DriverUnknown; x <= DriverHarness; x++ {
+		if x.String() == string(text) {
+			*d = x
+			return nil
+		}
+	}
+	*d = DriverUnknown
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded Driver. It also
+// accepts the legacy integer encoding.
+func (d *Driver) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, d, func(i int) { *d = Driver(i) })
+}
+
 // Role defines membership roles.
 type Role int
 
@@ -204,6 +286,30 @@ func (r Role) String() (s string) {
 	}
 }
 
+// MarshalText returns the text-encoded Role.
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded Role. Unknown
+// values are decoded as RoleUndefined.
+func (r *Role) UnmarshalText(text []byte) error {
+	for x := RoleUndefined; x <= RoleAdmin; x++ {
+		if x.String() == string(text) {
+			*r = x
+			return nil
+		}
+	}
+	*r = RoleUndefined
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded Role. It also
+// accepts the legacy integer encoding.
+func (r *Role) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, r, func(i int) { *r = Role(i) })
+}
+
 // ContentKind defines the kind of a content in a directory.
 type ContentKind int
 
@@ -232,7 +338,25 @@ func (k ContentKind) String() string {
 	}
 }
 
-// MarshalJSON returns the JSON-encoded Action.
+// MarshalText returns the text-encoded ContentKind.
+func (k ContentKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded ContentKind. Unknown
+// values are decoded as ContentKindUnsupported.
+func (k *ContentKind) UnmarshalText(text []byte) error {
+	for x := ContentKindUnsupported; x <= ContentKindGitlink; x++ {
+		if x.String() == string(text) {
+			*k = x
+			return nil
+		}
+	}
+	*k = ContentKindUnsupported
+	return nil
+}
+
+// MarshalJSON returns the JSON-encoded ContentKind.
 func (k ContentKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(k.String())
 }
@@ -243,21 +367,208 @@ func (k *ContentKind) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
+	return k.UnmarshalText([]byte(s))
+}
+
+// MergeMethod defines the pull request merge strategy.
+type MergeMethod int
+
+// MergeMethod values.
+const (
+	MergeMethodDefault MergeMethod = iota
+	MergeMethodMerge
+	MergeMethodSquash
+	MergeMethodRebase
+	MergeMethodFastForward
+)
+
+// String returns the string representation of MergeMethod.
+func (m MergeMethod) String() string {
+	switch m {
+	case MergeMethodMerge:
+		return "merge"
+	case MergeMethodSquash:
+		return "squash"
+	case MergeMethodRebase:
+		return "rebase"
+	case MergeMethodFastForward:
+		return "fast-forward"
+	default:
+		return "default"
+	}
+}
+
+// MarshalText returns the text-encoded MergeMethod.
+func (m MergeMethod) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded MergeMethod. Unknown
+// values are decoded as MergeMethodDefault.
+func (m *MergeMethod) UnmarshalText(text []byte) error {
+	for x := MergeMethodDefault; x <= MergeMethodFastForward; x++ {
+		if x.String() == string(text) {
+			*m = x
+			return nil
+		}
+	}
+	*m = MergeMethodDefault
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded MergeMethod. It also
+// accepts the legacy integer encoding.
+func (m *MergeMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, m, func(i int) { *m = MergeMethod(i) })
+}
+
+// ReviewState defines the state of a pull request review.
+type ReviewState int
+
+// ReviewState values.
+const (
+	ReviewStateUnknown ReviewState = iota
+	ReviewStatePending
+	ReviewStateCommented
+	ReviewStateApproved
+	ReviewStateChangesRequested
+	ReviewStateDismissed
+)
+
+// String returns the string representation of ReviewState.
+func (r ReviewState) String() string {
+	switch r {
+	case ReviewStatePending:
+		return "pending"
+	case ReviewStateCommented:
+		return "commented"
+	case ReviewStateApproved:
+		return "approved"
+	case ReviewStateChangesRequested:
+		return "changes_requested"
+	case ReviewStateDismissed:
+		return "dismissed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText returns the text-encoded ReviewState.
+func (r ReviewState) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded ReviewState. Unknown
+// values are decoded as ReviewStateUnknown.
+func (r *ReviewState) UnmarshalText(text []byte) error {
+	for x := ReviewStateUnknown; x <= ReviewStateDismissed; x++ {
+		if x.String() == string(text) {
+			*r = x
+			return nil
+		}
+	}
+	*r = ReviewStateUnknown
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded ReviewState. It also
+// accepts the legacy integer encoding.
+func (r *ReviewState) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, r, func(i int) { *r = ReviewState(i) })
+}
+
+// DiffSide defines the side of a diff.
+type DiffSide int
+
+// DiffSide values.
+const (
+	DiffSideHead DiffSide = iota
+	DiffSideBase
+)
+
+// String returns the string representation of DiffSide.
+func (d DiffSide) String() string {
+	switch d {
+	case DiffSideBase:
+		return "base"
+	default:
+		return "head"
+	}
+}
+
+// MarshalText returns the text-encoded DiffSide.
+func (d DiffSide) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded DiffSide. Unknown
+// values are decoded as DiffSideHead.
+func (d *DiffSide) UnmarshalText(text []byte) error {
+	for x := DiffSideHead; x <= DiffSideBase; x++ {
+		if x.String() == string(text) {
+			*d = x
+			return nil
+		}
+	}
+	*d = DiffSideHead
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded DiffSide. It also
+// accepts the legacy integer encoding.
+func (d *DiffSide) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, d, func(i int) { *d = DiffSide(i) })
+}
+
+// IssueSort defines the issue list sort order.
+type IssueSort int
+
+// IssueSort values.
+const (
+	IssueSortDefault IssueSort = iota
+	IssueSortCreated
+	IssueSortUpdated
+	IssueSortComments
+)
+
+// String returns the string representation of IssueSort.
+func (s IssueSort) String() string {
 	switch s {
-	case ContentKindFile.String():
-		*k = ContentKindFile
-	case ContentKindDirectory.String():
-		*k = ContentKindDirectory
-	case ContentKindSymlink.String():
-		*k = ContentKindSymlink
-	case ContentKindGitlink.String():
-		*k = ContentKindGitlink
+	case IssueSortCreated:
+		return "created"
+	case IssueSortUpdated:
+		return "updated"
+	case IssueSortComments:
+		return "comments"
 	default:
-		*k = ContentKindUnsupported
+		return "default"
+	}
+}
+
+// MarshalText returns the text-encoded IssueSort.
+func (s IssueSort) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded IssueSort. Unknown
+// values are decoded as IssueSortDefault.
+func (s *IssueSort) UnmarshalText(text []byte) error {
+	for x := IssueSortDefault; x <= IssueSortComments; x++ {
+		if x.String() == string(text) {
+			*s = x
+			return nil
+		}
 	}
+	*s = IssueSortDefault
 	return nil
 }
 
+// UnmarshalJSON unmarshals the JSON-encoded IssueSort. It also
+// accepts the legacy integer encoding.
+func (s *IssueSort) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, s, func(i int) { *s = IssueSort(i) })
+}
+
 // Visibility defines repository visibility.
 type Visibility int
 
@@ -283,4 +594,47 @@ func (v Visibility) String() (s string) {
 	}
 }
 
+// MarshalText returns the text-encoded Visibility.
+func (v Visibility) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText unmarshals the text-encoded Visibility. Unknown
+// values are decoded as VisibilityUndefined.
+func (v *Visibility) UnmarshalText(text []byte) error {
+	for x := VisibilityUndefined; x <= VisibilityPrivate; x++ {
+		if x.String() == string(text) {
+			*v = x
+			return nil
+		}
+	}
+	*v = VisibilityUndefined
+	return nil
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded Visibility. It also
+// accepts the legacy integer encoding.
+func (v *Visibility) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v, func(i int) { *v = Visibility(i) })
+}
+
+// helper function unmarshals a JSON-encoded enum from its
+// string representation, or from its legacy integer
+// representation.
+func unmarshalEnum(data []byte, v encoding.TextUnmarshaler, set func(int)) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var i int
+	if err := json.Unmarshal(data, &i); err == nil {
+		set(i)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
 const SearchTimeFormat = "2006-01-02T15:04:05Z"
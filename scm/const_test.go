@@ -0,0 +1,83 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+type enum interface {
+	fmt.Stringer
+	encoding.TextMarshaler
+}
+
+func TestEnumText(t *testing.T) {
+	tests := []struct {
+		value enum
+		text  string
+		ptr   encoding.TextUnmarshaler
+	}{
+		{StateSuccess, "success", new(State)},
+		{StateUnknown, "unknown", new(State)},
+		{ActionReviewReady, "review_ready", new(Action)},
+		{DriverHarness, "harness", new(Driver)},
+		{RoleAdmin, "admin", new(Role)},
+		{ContentKindSymlink, "symlink", new(ContentKind)},
+		{MergeMethodFastForward, "fast-forward", new(MergeMethod)},
+		{ReviewStateChangesRequested, "changes_requested", new(ReviewState)},
+		{DiffSideBase, "base", new(DiffSide)},
+		{IssueSortComments, "comments", new(IssueSort)},
+		{VisibilityInternal, "internal", new(Visibility)},
+	}
+	for _, test := range tests {
+		text, err := test.value.MarshalText()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got, want := string(text), test.text; got != want {
+			t.Errorf("Want text %q, got %q", want, got)
+		}
+		if err := test.ptr.UnmarshalText(text); err != nil {
+			t.Error(err)
+			continue
+		}
+		if got, want := test.ptr.(fmt.Stringer).String(), test.value.String(); got != want {
+			t.Errorf("Want value %s, got %s", want, got)
+		}
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var out struct {
+		State      State
+		Visibility Visibility
+		Side       DiffSide
+	}
+	data := []byte(`{"State":"failure","Visibility":3,"Side":"base"}`)
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.State, StateFailure; got != want {
+		t.Errorf("Want state %s, got %s", want, got)
+	}
+	if got, want := out.Visibility, VisibilityPrivate; got != want {
+		t.Errorf("Want visibility %s, got %s", want, got)
+	}
+	if got, want := out.Side, DiffSideBase; got != want {
+		t.Errorf("Want side %s, got %s", want, got)
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"State":"failure","Visibility":"private","Side":"base"}`; got != want {
+		t.Errorf("Want json %s, got %s", want, got)
+	}
+}
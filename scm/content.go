@@ -35,6 +35,15 @@ type (
 		Kind   ContentKind
 	}
 
+	// BlameRange maps a range of lines in a file to the
+	// commit that last modified them. Line numbers are
+	// one-based and inclusive.
+	BlameRange struct {
+		StartLine int
+		EndLine   int
+		Commit    Commit
+	}
+
 	// ContentService provides access to repositroy content.
 	ContentService interface {
 		// Find returns the repository file content by path.
@@ -53,5 +62,9 @@ type (
 		// up to the driver to list the directory recursively or non-recursively,
 		// but a robust driver should return a non-recursive list if possible.
 		List(ctx context.Context, repo, path, ref string, opts ListOptions) ([]*ContentInfo, *Response, error)
+
+		// Blame returns the line ranges of a file mapped to
+		// the commit that last modified each range.
+		Blame(ctx context.Context, repo, path, ref string) ([]*BlameRange, *Response, error)
 	}
 )
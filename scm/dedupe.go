@@ -0,0 +1,81 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"sync"
+	"time"
+)
+
+// Deduper detects redeliveries of webhook events, eg when
+// the provider retries a delivery that timed out.
+type Deduper interface {
+	// Seen records the webhook delivery and returns true
+	// if the delivery was already recorded. Webhooks without
+	// a delivery identifier are never considered seen.
+	Seen(Webhook) bool
+}
+
+// MemoryDeduper is an in-memory Deduper that records
+// webhook deliveries for a fixed duration.
+type MemoryDeduper struct {
+	mu   sync.Mutex
+	ttl  time.Duration
+	seen map[string]time.Time
+
+	// now returns the current time, and can be
+	// replaced for testing purposes.
+	now func() time.Time
+}
+
+// NewMemoryDeduper returns a new in-memory Deduper that
+// records webhook deliveries for the ttl duration.
+func NewMemoryDeduper(ttl time.Duration) *MemoryDeduper {
+	return &MemoryDeduper{
+		ttl:  ttl,
+		seen: map[string]time.Time{},
+		now:  time.Now,
+	}
+}
+
+// Seen records the webhook delivery and returns true if
+// the delivery was recorded within the ttl duration.
+func (d *MemoryDeduper) Seen(hook Webhook) bool {
+	meta := hook.Meta()
+	if meta.GUID == "" {
+		return false
+	}
+	key := meta.Driver.String() + ":" + meta.GUID
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.expire(now)
+
+	if _, ok := d.seen[key]; ok {
+		return true
+	}
+	d.seen[key] = now.Add(d.ttl)
+	return false
+}
+
+// Len returns the number of recorded deliveries that
+// have not expired.
+func (d *MemoryDeduper) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expire(d.now())
+	return len(d.seen)
+}
+
+// helper function removes expired deliveries.
+func (d *MemoryDeduper) expire(now time.Time) {
+	for key, expires := range d.seen {
+		if !now.Before(expires) {
+			delete(d.seen, key)
+		}
+	}
+}
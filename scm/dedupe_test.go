@@ -0,0 +1,67 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryDeduper(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewMemoryDeduper(time.Minute)
+	d.now = func() time.Time { return now }
+
+	hook := &PushHook{
+		Metadata: WebhookMeta{
+			Driver: DriverGithub,
+			GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+			Event:  "push",
+		},
+	}
+	if d.Seen(hook) {
+		t.Errorf("Want first delivery not seen")
+	}
+	if !d.Seen(hook) {
+		t.Errorf("Want redelivery seen")
+	}
+
+	// the same delivery identifier from a different
+	// driver is a different delivery.
+	other := &PushHook{
+		Metadata: WebhookMeta{
+			Driver: DriverGitea,
+			GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		},
+	}
+	if d.Seen(other) {
+		t.Errorf("Want delivery from a different driver not seen")
+	}
+	if got, want := d.Len(), 2; got != want {
+		t.Errorf("Want %d recorded deliveries, got %d", want, got)
+	}
+
+	// the delivery is forgotten once the ttl expires.
+	now = now.Add(time.Minute)
+	if got, want := d.Len(), 0; got != want {
+		t.Errorf("Want %d recorded deliveries, got %d", want, got)
+	}
+	if d.Seen(hook) {
+		t.Errorf("Want expired delivery not seen")
+	}
+}
+
+func TestMemoryDeduper_NoGUID(t *testing.T) {
+	d := NewMemoryDeduper(time.Minute)
+	hook := &PushHook{
+		Metadata: WebhookMeta{Driver: DriverGitee},
+	}
+	if d.Seen(hook) || d.Seen(hook) {
+		t.Errorf("Want delivery without guid never seen")
+	}
+	if got, want := d.Len(), 0; got != want {
+		t.Errorf("Want %d recorded deliveries, got %d", want, got)
+	}
+}
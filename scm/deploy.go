@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Deployment represents a repository deployment.
+	Deployment struct {
+		ID          string
+		Ref         string
+		Sha         string
+		Task        string
+		Environment string
+		Desc        string
+		State       State
+		Link        string
+		Author      User
+		Created     time.Time
+		Updated     time.Time
+	}
+
+	// DeploymentInput provides the input fields required
+	// for creating a deployment.
+	DeploymentInput struct {
+		Ref         string
+		Sha         string
+		Task        string
+		Environment string
+		Desc        string
+		Payload     interface{}
+
+		// Fields are optional. The provider may choose to
+		// ignore them if the concept is not supported.
+		Production bool
+		Transient  bool
+	}
+
+	// DeploymentListOptions provides options for querying
+	// a list of repository deployments.
+	DeploymentListOptions struct {
+		Ref         string
+		Sha         string
+		Task        string
+		Environment string
+		Page        int
+		Size        int
+	}
+
+	// DeployStatusInput provides the input fields required
+	// for creating a deployment status.
+	DeployStatusInput struct {
+		State          State
+		Desc           string
+		Target         string
+		Environment    string
+		EnvironmentURL string
+	}
+
+	// Environment represents a deployment environment.
+	Environment struct {
+		ID      string
+		Name    string
+		Desc    string
+		Link    string
+		Created time.Time
+		Updated time.Time
+	}
+
+	// DeploymentService provides access to deployment and
+	// environment resources.
+	DeploymentService interface {
+		// Find returns the repository deployment by id.
+		Find(ctx context.Context, repo, id string) (*Deployment, *Response, error)
+
+		// List returns the repository deployment list.
+		List(ctx context.Context, repo string, opts DeploymentListOptions) ([]*Deployment, *Response, error)
+
+		// Create creates a new deployment.
+		Create(ctx context.Context, repo string, input *DeploymentInput) (*Deployment, *Response, error)
+
+		// CreateStatus creates a new deployment status.
+		CreateStatus(ctx context.Context, repo, id string, input *DeployStatusInput) (*DeployStatus, *Response, error)
+
+		// ListStatuses returns the deployment status list.
+		ListStatuses(ctx context.Context, repo, id string, opts ListOptions) ([]*DeployStatus, *Response, error)
+
+		// ListEnvironments returns the repository deployment
+		// environment list.
+		ListEnvironments(ctx context.Context, repo string, opts ListOptions) ([]*Environment, *Response, error)
+	}
+)
@@ -0,0 +1,351 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Diff represents a unified diff.
+	Diff struct {
+		// Raw is the unified diff text.
+		Raw   string
+		Files []*FileDiff
+	}
+
+	// FileDiff represents the changes made to a single
+	// file. OldPath is empty for added files and NewPath
+	// is empty for deleted files.
+	FileDiff struct {
+		OldPath   string
+		NewPath   string
+		OldMode   string
+		NewMode   string
+		Added     bool
+		Deleted   bool
+		Renamed   bool
+		Binary    bool
+		Additions int
+		Deletions int
+		Hunks     []*Hunk
+	}
+
+	// Hunk represents a contiguous block of changed lines.
+	Hunk struct {
+		OldStart int
+		OldLines int
+		NewStart int
+		NewLines int
+
+		// Section is the optional text that follows the
+		// hunk range, typically the enclosing function.
+		Section string
+		Lines   []*DiffLine
+	}
+
+	// DiffLine represents a single line in a hunk. Lines
+	// that are neither added nor deleted are context lines.
+	DiffLine struct {
+		Added   bool
+		Deleted bool
+		Content string
+
+		// OldLine and NewLine are the line numbers in the
+		// old and new file, and are zero for added and
+		// deleted lines respectively.
+		OldLine int
+		NewLine int
+
+		// Position is the line offset from the first hunk
+		// header of the file, as used to anchor review
+		// comments to a diff.
+		Position int
+	}
+)
+
+// ParseDiff parses the unified diff text. Text that
+// precedes the first file header, such as the message of
+// an email patch, is ignored.
+func ParseDiff(raw string) (*Diff, error) {
+	p := new(diffParser)
+	for i, line := range strings.Split(raw, "\n") {
+		if err := p.parse(line); err != nil {
+			return nil, fmt.Errorf("diff: line %d: %w", i+1, err)
+		}
+	}
+	return &Diff{Raw: raw, Files: p.files}, nil
+}
+
+// FormatFileDiff returns the unified diff of a single
+// file given its hunks. It is used to construct a diff
+// for providers that only return the hunks of each file.
+func FormatFileDiff(file *FileDiff, hunks string) string {
+	oldPath, newPath := file.OldPath, file.NewPath
+	if oldPath == "" {
+		oldPath = newPath
+	}
+	if newPath == "" {
+		newPath = oldPath
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "diff --git a/%s b/%s\n", oldPath, newPath)
+	switch {
+	case file.Added:
+		fmt.Fprintf(&b, "new file mode %s\n", file.NewMode)
+	case file.Deleted:
+		fmt.Fprintf(&b, "deleted file mode %s\n", file.OldMode)
+	case file.OldMode != file.NewMode && file.OldMode != "" && file.NewMode != "":
+		fmt.Fprintf(&b, "old mode %s\nnew mode %s\n", file.OldMode, file.NewMode)
+	}
+	if file.Renamed {
+		fmt.Fprintf(&b, "rename from %s\nrename to %s\n", oldPath, newPath)
+	}
+	if hunks == "" {
+		return b.String()
+	}
+	// some providers include the file headers, or the
+	// binary notice, with the hunks.
+	if strings.HasPrefix(hunks, "Binary files") || strings.HasPrefix(hunks, "--- ") {
+		b.WriteString(hunks)
+	} else {
+		if file.Added {
+			b.WriteString("--- /dev/null\n")
+		} else {
+			fmt.Fprintf(&b, "--- a/%s\n", oldPath)
+		}
+		if file.Deleted {
+			b.WriteString("+++ /dev/null\n")
+		} else {
+			fmt.Fprintf(&b, "+++ b/%s\n", newPath)
+		}
+		b.WriteString(hunks)
+	}
+	if !strings.HasSuffix(hunks, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// diffParser is a line based unified diff parser.
+type diffParser struct {
+	files []*FileDiff
+	file  *FileDiff
+	hunk  *Hunk
+
+	oldLine, newLine int // next line number
+	oldLeft, newLeft int // lines remaining in the hunk
+	position         int
+}
+
+func (p *diffParser) parse(line string) error {
+	// lines within the range of the current hunk are
+	// always hunk content.
+	if p.hunk != nil && (p.oldLeft > 0 || p.newLeft > 0) {
+		return p.parseHunkLine(line)
+	}
+	switch {
+	case strings.HasPrefix(line, "diff --git "):
+		p.begin()
+		p.file.OldPath, p.file.NewPath = splitDiffGitPaths(line[len("diff --git "):])
+	case p.file == nil && !strings.HasPrefix(line, "--- "):
+		// ignore any preamble.
+	case strings.HasPrefix(line, "new file mode "):
+		p.file.Added = true
+		p.file.OldPath = ""
+		p.file.NewMode = line[len("new file mode "):]
+	case strings.HasPrefix(line, "deleted file mode "):
+		p.file.Deleted = true
+		p.file.NewPath = ""
+		p.file.OldMode = line[len("deleted file mode "):]
+	case strings.HasPrefix(line, "old mode "):
+		p.file.OldMode = line[len("old mode "):]
+	case strings.HasPrefix(line, "new mode "):
+		p.file.NewMode = line[len("new mode "):]
+	case strings.HasPrefix(line, "rename from "):
+		p.file.Renamed = true
+		p.file.OldPath = unquoteDiffPath(line[len("rename from "):])
+	case strings.HasPrefix(line, "rename to "):
+		p.file.Renamed = true
+		p.file.NewPath = unquoteDiffPath(line[len("rename to "):])
+	case strings.HasPrefix(line, "index "):
+		// the index line includes the file mode when
+		// the mode is unchanged.
+		if fields := strings.Fields(line); len(fields) == 3 && p.file.OldMode == "" && p.file.NewMode == "" {
+			p.file.OldMode, p.file.NewMode = fields[2], fields[2]
+		}
+	case strings.HasPrefix(line, "Binary files "), line == "GIT binary patch":
+		p.file.Binary = true
+	case strings.HasPrefix(line, "--- "):
+		// diffs without a git header start each file
+		// with the old file path.
+		if p.file == nil || len(p.file.Hunks) != 0 {
+			p.begin()
+		}
+		if path, ok := trimDiffPath(line[len("--- "):], "a/"); ok {
+			p.file.OldPath = path
+		} else {
+			p.file.OldPath = ""
+			p.file.Added = true
+		}
+	case strings.HasPrefix(line, "+++ "):
+		if path, ok := trimDiffPath(line[len("+++ "):], "b/"); ok {
+			p.file.NewPath = path
+		} else {
+			p.file.NewPath = ""
+			p.file.Deleted = true
+		}
+	case strings.HasPrefix(line, "@@ "):
+		return p.parseHunkHeader(line)
+	}
+	return nil
+}
+
+// begin starts a new file.
+func (p *diffParser) begin() {
+	p.file = new(FileDiff)
+	p.files = append(p.files, p.file)
+	p.hunk = nil
+	p.position = 0
+}
+
+func (p *diffParser) parseHunkHeader(line string) error {
+	if p.file == nil {
+		return fmt.Errorf("hunk without a file header")
+	}
+	end := strings.Index(line[3:], " @@")
+	if end == -1 {
+		return fmt.Errorf("malformed hunk header %q", line)
+	}
+	ranges := strings.Fields(line[3 : end+3])
+	if len(ranges) != 2 || ranges[0][0] != '-' || ranges[1][0] != '+' {
+		return fmt.Errorf("malformed hunk header %q", line)
+	}
+	hunk := new(Hunk)
+	var err error
+	if hunk.OldStart, hunk.OldLines, err = parseHunkRange(ranges[0][1:]); err != nil {
+		return err
+	}
+	if hunk.NewStart, hunk.NewLines, err = parseHunkRange(ranges[1][1:]); err != nil {
+		return err
+	}
+	hunk.Section = strings.TrimSpace(line[end+6:])
+
+	// the header of each hunk after the first counts
+	// towards the diff position.
+	if len(p.file.Hunks) != 0 {
+		p.position++
+	}
+	p.file.Hunks = append(p.file.Hunks, hunk)
+	p.hunk = hunk
+	p.oldLine, p.oldLeft = hunk.OldStart, hunk.OldLines
+	p.newLine, p.newLeft = hunk.NewStart, hunk.NewLines
+	return nil
+}
+
+func (p *diffParser) parseHunkLine(line string) error {
+	if strings.HasPrefix(line, "\\") {
+		// no newline at end of file marker.
+		return nil
+	}
+	p.position++
+	l := &DiffLine{Position: p.position}
+	switch {
+	case strings.HasPrefix(line, "+"):
+		l.Added = true
+		l.Content = line[1:]
+		l.NewLine = p.newLine
+		p.newLine++
+		p.newLeft--
+		p.file.Additions++
+	case strings.HasPrefix(line, "-"):
+		l.Deleted = true
+		l.Content = line[1:]
+		l.OldLine = p.oldLine
+		p.oldLine++
+		p.oldLeft--
+		p.file.Deletions++
+	case line == "" || line[0] == ' ':
+		// some providers strip the leading space from
+		// empty context lines.
+		if line != "" {
+			l.Content = line[1:]
+		}
+		l.OldLine = p.oldLine
+		l.NewLine = p.newLine
+		p.oldLine++
+		p.newLine++
+		p.oldLeft--
+		p.newLeft--
+	default:
+		return fmt.Errorf("unexpected hunk line %q", line)
+	}
+	p.hunk.Lines = append(p.hunk.Lines, l)
+	return nil
+}
+
+// parseHunkRange parses a hunk range in the format
+// start,count. The count defaults to 1 when omitted.
+func parseHunkRange(s string) (start, count int, err error) {
+	count = 1
+	if i := strings.IndexByte(s, ','); i != -1 {
+		if count, err = strconv.Atoi(s[i+1:]); err != nil {
+			return 0, 0, fmt.Errorf("malformed hunk range %q", s)
+		}
+		s = s[:i]
+	}
+	if start, err = strconv.Atoi(s); err != nil {
+		return 0, 0, fmt.Errorf("malformed hunk range %q", s)
+	}
+	return start, count, nil
+}
+
+// splitDiffGitPaths splits the paths of a diff --git
+// header. Unquoted paths that contain spaces are split
+// where both halves match, or at the last b/ prefix.
+func splitDiffGitPaths(s string) (string, string) {
+	if strings.HasPrefix(s, `"`) {
+		if i := strings.Index(s, `" `); i != -1 {
+			a, _ := trimDiffPath(s[:i+1], "a/")
+			b, _ := trimDiffPath(s[i+2:], "b/")
+			return a, b
+		}
+	}
+	if n := len(s); n%2 == 1 {
+		if a, b := s[:n/2], s[n/2+1:]; strings.TrimPrefix(a, "a/") == strings.TrimPrefix(b, "b/") {
+			return strings.TrimPrefix(a, "a/"), strings.TrimPrefix(b, "b/")
+		}
+	}
+	if i := strings.LastIndex(s, " b/"); i != -1 {
+		return strings.TrimPrefix(s[:i], "a/"), s[i+3:]
+	}
+	return s, s
+}
+
+// trimDiffPath trims the prefix and any trailing
+// timestamp from a file path. It returns false if the
+// path is /dev/null.
+func trimDiffPath(s, prefix string) (string, bool) {
+	if i := strings.IndexByte(s, '\t'); i != -1 {
+		s = s[:i]
+	}
+	if s == "/dev/null" {
+		return "", false
+	}
+	return strings.TrimPrefix(unquoteDiffPath(s), prefix), true
+}
+
+// unquoteDiffPath unquotes a path that git quoted because
+// it contains special characters.
+func unquoteDiffPath(s string) string {
+	if strings.HasPrefix(s, `"`) {
+		if unquoted, err := strconv.Unquote(s); err == nil {
+			return unquoted
+		}
+	}
+	return s
+}
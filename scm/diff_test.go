@@ -0,0 +1,174 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testDiff = `From 9a3c4e1 Mon Sep 17 00:00:00 2001
+Subject: [PATCH] update readme
+
+---
+ README.md | 3 ++-
+ 1 file changed, 2 insertions(+), 1 deletion(-)
+
+diff --git a/README.md b/README.md
+index 3b18e51..a2b4c6d 100644
+--- a/README.md
++++ b/README.md
+@@ -1,3 +1,4 @@ Hello
+ Hello World
+-foo
++bar
++baz
+
+@@ -10 +11 @@ func main() {
+-a
+\ No newline at end of file
++b
+\ No newline at end of file
+diff --git a/old name.txt b/new name.txt
+similarity index 100%
+rename from old name.txt
+rename to new name.txt
+diff --git a/logo.png b/logo.png
+new file mode 100644
+index 0000000..a2b4c6d
+Binary files /dev/null and b/logo.png differ
+diff --git a/run.sh b/run.sh
+old mode 100644
+new mode 100755
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+index 3b18e51..0000000
+--- a/gone.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+
+func TestParseDiff(t *testing.T) {
+	got, err := ParseDiff(testDiff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*FileDiff{
+		{
+			OldPath:   "README.md",
+			NewPath:   "README.md",
+			OldMode:   "100644",
+			NewMode:   "100644",
+			Additions: 3,
+			Deletions: 2,
+			Hunks: []*Hunk{
+				{
+					OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 4,
+					Section: "Hello",
+					Lines: []*DiffLine{
+						{Content: "Hello World", OldLine: 1, NewLine: 1, Position: 1},
+						{Deleted: true, Content: "foo", OldLine: 2, Position: 2},
+						{Added: true, Content: "bar", NewLine: 2, Position: 3},
+						{Added: true, Content: "baz", NewLine: 3, Position: 4},
+						{Content: "", OldLine: 3, NewLine: 4, Position: 5},
+					},
+				},
+				{
+					OldStart: 10, OldLines: 1, NewStart: 11, NewLines: 1,
+					Section: "func main() {",
+					Lines: []*DiffLine{
+						{Deleted: true, Content: "a", OldLine: 10, Position: 7},
+						{Added: true, Content: "b", NewLine: 11, Position: 8},
+					},
+				},
+			},
+		},
+		{
+			OldPath: "old name.txt",
+			NewPath: "new name.txt",
+			Renamed: true,
+		},
+		{
+			NewPath: "logo.png",
+			NewMode: "100644",
+			Added:   true,
+			Binary:  true,
+		},
+		{
+			OldPath: "run.sh",
+			NewPath: "run.sh",
+			OldMode: "100644",
+			NewMode: "100755",
+		},
+		{
+			OldPath:   "gone.txt",
+			OldMode:   "100644",
+			Deleted:   true,
+			Deletions: 1,
+			Hunks: []*Hunk{
+				{
+					OldStart: 1, OldLines: 1, NewStart: 0, NewLines: 0,
+					Lines: []*DiffLine{
+						{Deleted: true, Content: "gone", OldLine: 1, Position: 1},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if got.Raw != testDiff {
+		t.Errorf("Want raw diff to be preserved")
+	}
+}
+
+func TestParseDiff_NoGitHeader(t *testing.T) {
+	got, err := ParseDiff("--- a/a.txt\t2024-01-01\n+++ b/a.txt\n@@ -1 +1 @@\n-a\n+b\n--- /dev/null\n+++ b/b.txt\n@@ -0,0 +1 @@\n+b\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(got.Files), 2; got != want {
+		t.Fatalf("Want %d files, got %d", want, got)
+	}
+	if got, want := got.Files[0].OldPath, "a.txt"; got != want {
+		t.Errorf("Want old path %q, got %q", want, got)
+	}
+	if !got.Files[1].Added || got.Files[1].NewPath != "b.txt" {
+		t.Errorf("Want file b.txt added")
+	}
+}
+
+func TestParseDiff_Malformed(t *testing.T) {
+	_, err := ParseDiff("diff --git a/a.txt b/a.txt\n@@ -x +1 @@\n")
+	if err == nil {
+		t.Errorf("Want error parsing malformed hunk header")
+	}
+}
+
+func TestFormatFileDiff(t *testing.T) {
+	file := &FileDiff{
+		NewPath: "a.txt",
+		NewMode: "100644",
+		Added:   true,
+	}
+	got := FormatFileDiff(file, "@@ -0,0 +1 @@\n+a")
+	want := "diff --git a/a.txt b/a.txt\nnew file mode 100644\n--- /dev/null\n+++ b/a.txt\n@@ -0,0 +1 @@\n+a\n"
+	if got != want {
+		t.Errorf("Want diff %q, got %q", want, got)
+	}
+
+	diff, err := ParseDiff(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(diff.Files[0].Hunks[0].Lines, []*DiffLine{{Added: true, Content: "a", NewLine: 1, Position: 1}}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -39,12 +39,15 @@ func New(uri, owner, project string) (*scm.Client, error) {
 	client.Driver = scm.DriverAzure
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &RepositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -133,6 +133,10 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 	return convertContentInfoList(out.Value), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type content struct {
 	ObjectID      string `json:"objectId"`
 	GitObjectType string `json:"gitObjectType"`
@@ -203,6 +207,15 @@ func convertContentInfo(from *content) *scm.ContentInfo {
 	return to
 }
 
+// helper function returns the version type of the ref,
+// which is a commit if the ref is a full length sha.
+func versionType(ref string) string {
+	if len(ref) == 40 {
+		return "commit"
+	}
+	return "branch"
+}
+
 func generateURIFromRef(ref string) (uri string) {
 	if ref != "" {
 		if len(ref) == 40 {
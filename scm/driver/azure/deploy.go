@@ -0,0 +1,173 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo, id string) (*scm.Deployment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// List returns the deployment records of the environment
+// identified by opts.Environment. Azure environments are
+// scoped to the project, so the repository is ignored.
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/distributedtask/environmentdeployment-records/list?view=azure-devops-rest-7.1
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if opts.Environment == "" {
+		return nil, nil, errors.New("This API endpoint requires an environment to be specified")
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/distributedtask/environments/%s/environmentdeploymentrecords?api-version=7.1-preview.1",
+		s.client.owner, s.client.project, opts.Environment)
+	if opts.Size != 0 {
+		endpoint += fmt.Sprintf("&top=%d", opts.Size)
+	}
+	out := new(deploymentRecordList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertDeploymentRecordList(out.Value), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo, id string, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListStatuses(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListEnvironments returns the project environment list.
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/distributedtask/environments/list?view=azure-devops-rest-7.1
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/distributedtask/environments?api-version=7.1-preview.1",
+		s.client.owner, s.client.project)
+	if opts.Size != 0 {
+		endpoint += fmt.Sprintf("&$top=%d", opts.Size)
+	}
+	out := new(environmentList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertEnvironmentList(out.Value), res, err
+}
+
+type environmentList struct {
+	Count int            `json:"count"`
+	Value []*environment `json:"value"`
+}
+
+type environment struct {
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	CreatedOn      time.Time `json:"createdOn"`
+	LastModifiedOn time.Time `json:"lastModifiedOn"`
+}
+
+type deploymentRecordList struct {
+	Count int                 `json:"count"`
+	Value []*deploymentRecord `json:"value"`
+}
+
+type deploymentRecord struct {
+	ID            int    `json:"id"`
+	EnvironmentID int    `json:"environmentId"`
+	StageName     string `json:"stageName"`
+	JobName       string `json:"jobName"`
+	Definition    struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"definition"`
+	Owner struct {
+		ID    int `json:"id"`
+		Links struct {
+			Web struct {
+				Href string `json:"href"`
+			} `json:"web"`
+		} `json:"_links"`
+	} `json:"owner"`
+	Result     string    `json:"result"`
+	QueueTime  time.Time `json:"queueTime"`
+	StartTime  time.Time `json:"startTime"`
+	FinishTime time.Time `json:"finishTime"`
+}
+
+func convertEnvironmentList(from []*environment) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *environment) *scm.Environment {
+	return &scm.Environment{
+		ID:      strconv.Itoa(from.ID),
+		Name:    from.Name,
+		Desc:    from.Description,
+		Created: from.CreatedOn,
+		Updated: from.LastModifiedOn,
+	}
+}
+
+func convertDeploymentRecordList(from []*deploymentRecord) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeploymentRecord(v))
+	}
+	return to
+}
+
+func convertDeploymentRecord(from *deploymentRecord) *scm.Deployment {
+	to := &scm.Deployment{
+		ID:          strconv.Itoa(from.ID),
+		Task:        from.Definition.Name,
+		Environment: strconv.Itoa(from.EnvironmentID),
+		Desc:        from.StageName,
+		State:       convertDeployResult(from.Result),
+		Link:        from.Owner.Links.Web.Href,
+		Created:     from.QueueTime,
+		Updated:     from.FinishTime,
+	}
+	if to.Updated.IsZero() {
+		to.Updated = from.StartTime
+	}
+	return to
+}
+
+// helper function converts the deployment record result.
+// A record without a result is still in progress.
+func convertDeployResult(from string) scm.State {
+	switch from {
+	case "":
+		return scm.StateRunning
+	case "succeeded", "succeededWithIssues":
+		return scm.StateSuccess
+	case "failed":
+		return scm.StateFailure
+	case "canceled", "abandoned", "skipped":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentListEnvironments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Get("/ORG/PROJ/_apis/distributedtask/environments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/environments.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Deployments.ListEnvironments(context.Background(), "test_project", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Environment{}
+	raw, _ := os.ReadFile("testdata/environments.json.golden")
+	jsonErr := json.Unmarshal(raw, &want)
+	if jsonErr != nil {
+		t.Error(jsonErr)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Get("/ORG/PROJ/_apis/distributedtask/environments/1/environmentdeploymentrecords").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploys.json")
+
+	client := NewDefault("ORG", "PROJ")
+	opts := scm.DeploymentListOptions{Environment: "1"}
+	got, _, err := client.Deployments.List(context.Background(), "test_project", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := os.ReadFile("testdata/deploys.json.golden")
+	jsonErr := json.Unmarshal(raw, &want)
+	if jsonErr != nil {
+		t.Error(jsonErr)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeploymentList_NoEnvironment(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Deployments.List(context.Background(), "test_project", scm.DeploymentListOptions{})
+	if err == nil {
+		t.Errorf("Expect error when no environment is specified")
+	}
+}
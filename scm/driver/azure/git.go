@@ -7,6 +7,7 @@ package azure
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -48,6 +49,12 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	// azure does not provide an endpoint that returns the
+	// unified diff of a commit.
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -93,6 +100,17 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out.Value), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/commits/get-commits?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits?%s", s.client.owner, s.client.project, repo, encodeFileCommitListOptions(path, opts))
+	out := new(commitList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out.Value), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -101,6 +119,53 @@ func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.Li
 	return nil, nil, scm.ErrNotSupported
 }
 
+// Compare compares the head commit with the base commit. The
+// diffs api does not return the commits, so the commit list
+// is not set.
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	out, res, err := s.diff(ctx, repo, base, head)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Comparison{
+		MergeBase: out.CommonCommit,
+		Ahead:     int(out.AheadCount),
+		Behind:    int(out.BehindCount),
+		Changes:   convertChangeList(out.Changes),
+	}, res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	out, res, err := s.diff(ctx, repo, base, head)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.FindCommit(ctx, repo, out.CommonCommit)
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	out, res, err := s.diff(ctx, repo, ancestor, descendant)
+	if err != nil {
+		return false, res, err
+	}
+	return out.BehindCount == 0, res, nil
+}
+
+// helper function returns the diff between the base and the
+// target versions, which may be a commit sha or a branch name.
+func (s *gitService) diff(ctx context.Context, repo, base, target string) (*compare, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/diffs/commits?", s.client.owner, s.client.project, repo)
+	endpoint += fmt.Sprintf("baseVersion=%s&baseVersionType=%s&", base, versionType(base))
+	endpoint += fmt.Sprintf("targetVersion=%s&targetVersionType=%s&api-version=6.0", target, versionType(target))
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return out, res, err
+}
+
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-6.0
 	if s.client.project == "" {
@@ -118,6 +183,106 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(changes), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if params.Message != "" {
+		// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/annotated-tags/create?view=azure-devops-rest-6.0
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/annotatedtags?api-version=6.0", s.client.owner, s.client.project, repo)
+		in := new(annotatedTag)
+		in.Name = params.Name
+		in.Message = params.Message
+		in.TaggedObject.ObjectID = params.Sha
+		out := new(annotatedTag)
+		res, err := s.client.do(ctx, "POST", endpoint, in, out)
+		return convertAnnotatedTag(out), res, err
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?api-version=6.0", s.client.owner, s.client.project, repo)
+	in := make(crudBranch, 1)
+	in[0].Name = scm.ExpandRef(params.Name, "refs/tags")
+	in[0].NewObjectID = params.Sha
+	in[0].OldObjectID = scm.EmptyCommit
+	res, err := s.client.do(ctx, "POST", endpoint, in, nil)
+	return &scm.Tag{
+		Name: params.Name,
+		Path: in[0].Name,
+		Sha:  params.Sha,
+	}, res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return s.deleteRef(ctx, repo, scm.ExpandRef(name, "refs/heads"))
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return s.deleteRef(ctx, repo, scm.ExpandRef(name, "refs/tags"))
+}
+
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	ref, res, err := s.findRef(ctx, repo, scm.ExpandRef(name, "refs/tags"))
+	if err != nil {
+		return nil, res, err
+	}
+	// lightweight tags point directly at the commit and
+	// are not peeled.
+	if ref.PeeledObjectID == "" {
+		return &scm.Tag{
+			Name: scm.TrimRef(ref.Name),
+			Path: ref.Name,
+			Sha:  ref.ObjectID,
+		}, res, nil
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/annotated-tags/get?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/annotatedtags/%s?api-version=6.0", s.client.owner, s.client.project, repo, ref.ObjectID)
+	out := new(annotatedTag)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, out)
+	tag := convertAnnotatedTag(out)
+	tag.Sha = ref.PeeledObjectID
+	return tag, res, err
+}
+
+// deleteRef deletes the named reference. Azure requires the
+// current object id of the reference in order to delete it.
+func (s *gitService) deleteRef(ctx context.Context, repo, name string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	ref, res, err := s.findRef(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?api-version=6.0", s.client.owner, s.client.project, repo)
+	in := make(crudBranch, 1)
+	in[0].Name = name
+	in[0].OldObjectID = ref.ObjectID
+	in[0].NewObjectID = scm.EmptyCommit
+	return s.client.do(ctx, "POST", endpoint, in, nil)
+}
+
+// findRef returns the reference that exactly matches the
+// fully qualified name.
+func (s *gitService) findRef(ctx context.Context, repo, name string) (*branch, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/list?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?filter=%s&peelTags=true&api-version=6.0", s.client.owner, s.client.project, repo, strings.TrimPrefix(name, "refs/"))
+	out := new(branchList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range out.Value {
+		if v.Name == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
 type crudBranch []struct {
 	Name        string `json:"name"`
 	OldObjectID string `json:"oldObjectId"`
@@ -130,9 +295,10 @@ type branchList struct {
 }
 
 type branch struct {
-	Name     string `json:"name"`
-	ObjectID string `json:"objectId"`
-	Creator  struct {
+	Name           string `json:"name"`
+	ObjectID       string `json:"objectId"`
+	PeeledObjectID string `json:"peeledObjectId,omitempty"`
+	Creator        struct {
 		DisplayName string `json:"displayName"`
 		URL         string `json:"url"`
 		Links       struct {
@@ -175,6 +341,21 @@ type gitCommit struct {
 	RemoteURL string `json:"remoteUrl"`
 }
 
+type annotatedTag struct {
+	Name         string `json:"name"`
+	ObjectID     string `json:"objectId,omitempty"`
+	Message      string `json:"message"`
+	TaggedObject struct {
+		ObjectID   string `json:"objectId"`
+		ObjectType string `json:"objectType,omitempty"`
+	} `json:"taggedObject"`
+	TaggedBy *struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"taggedBy,omitempty"`
+}
+
 type file struct {
 	ChangeType string `json:"changeType"`
 	Item       struct {
@@ -246,6 +427,25 @@ func convertCommit(from *gitCommit) *scm.Commit {
 	}
 }
 
+func convertAnnotatedTag(from *annotatedTag) *scm.Tag {
+	to := &scm.Tag{
+		Name:    from.Name,
+		Path:    scm.ExpandRef(from.Name, "refs/tags"),
+		Message: from.Message,
+		Object:  from.ObjectID,
+		Sha:     from.TaggedObject.ObjectID,
+	}
+	if from.TaggedBy != nil {
+		to.Tagger = scm.Signature{
+			Login: from.TaggedBy.Name,
+			Name:  from.TaggedBy.Name,
+			Email: from.TaggedBy.Email,
+			Date:  from.TaggedBy.Date,
+		}
+	}
+	return to
+}
+
 func convertChangeList(from []*file) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -97,6 +98,40 @@ func TestGitListCommits(t *testing.T) {
 	}
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/commits").
+		MatchParam("searchCriteria.itemPath", "README.md").
+		MatchParam("searchCriteria.itemVersion.version", "main").
+		MatchParam("searchCriteria.fromDate", "2021-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{
+		Ref:   "main",
+		Since: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.ListFileCommits(context.Background(), "REPOID", "README.md", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -175,3 +210,212 @@ func TestGitCompareChanges(t *testing.T) {
 	}
 
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/diffs/commits").
+		MatchParam("baseVersion", "main").
+		MatchParam("baseVersionType", "branch").
+		MatchParam("targetVersion", "66df312dad61e84dd896d1e8d14ee3dce53b62f0").
+		MatchParam("targetVersionType", "commit").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.Compare(context.Background(), "REPOID", "main", "66df312dad61e84dd896d1e8d14ee3dce53b62f0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.MergeBase, "9788e5ddf8b387cb79228628f34d8dc18582d606"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+	if got, want := got.Ahead, 10; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/compare.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/diffs/commits").
+		MatchParam("baseVersion", "main").
+		MatchParam("targetVersion", "feature").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.IsAncestor(context.Background(), "REPOID", "main", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want main to be an ancestor of feature")
+	}
+}
+
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_refs.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/annotatedtags/c7e1e2d5e4a1bbbc1b3a1f1c6d8b6a0fb2c4e1d3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/annotated_tag.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.FindTagDetails(context.Background(), "REPOID", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/annotated_tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/annotatedtags").
+		Reply(201).
+		Type("application/json").
+		File("testdata/annotated_tag.json")
+
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "ffe9cba521f00d7f60e322845072238635edb451",
+		Message: "First release",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.CreateTag(context.Background(), "REPOID", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/annotated_tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag_Lightweight(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch_create.json")
+
+	params := &scm.TagInput{
+		Name: "v1.0.0",
+		Sha:  "ffe9cba521f00d7f60e322845072238635edb451",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.CreateTag(context.Background(), "REPOID", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{
+		Name: "v1.0.0",
+		Path: "refs/tags/v1.0.0",
+		Sha:  "ffe9cba521f00d7f60e322845072238635edb451",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "heads/test_branch").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_refs.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		JSON([]map[string]string{{
+			"name":        "refs/heads/test_branch",
+			"oldObjectId": "312797ba52425353dec56871a255e2a36fc96344",
+			"newObjectId": "0000000000000000000000000000000000000000",
+		}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_create.json")
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.Git.DeleteBranch(context.Background(), "REPOID", "test_branch")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitDeleteTag_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "tags/v2.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_refs.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Git.DeleteTag(context.Background(), "REPOID", "v2.0.0")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
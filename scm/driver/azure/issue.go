@@ -34,6 +34,10 @@ func (s *issueService) Create(ctx context.Context, repo string, input *scm.Issue
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -46,6 +50,26 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return nil, scm.ErrNotSupported
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -6,6 +6,7 @@ package azure
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -25,3 +26,77 @@ func (s *organizationService) FindMembership(ctx context.Context, name, username
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/get?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions/%s?api-version=6.0", s.client.owner, id)
+	out := new(subscription)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/list?view=azure-devops-rest-6.0
+	repos := &RepositoryService{client: s.client}
+	projectID, projErr := repos.getProjectIDFromProjectName(ctx, name)
+	if projErr != nil {
+		return nil, nil, fmt.Errorf("ListHooks was unable to look up the project's projectID, %s", projErr)
+	}
+	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions?api-version=6.0", s.client.owner)
+	out := new(subscriptions)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertProjectHookList(out.Value, projectID), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/create?view=azure-devops-rest-6.0
+	if len(input.NativeEvents) != 1 {
+		return nil, nil, fmt.Errorf("CreateHook, Azure requires a single native event %v", input.NativeEvents)
+	}
+	repos := &RepositoryService{client: s.client}
+	projectID, projErr := repos.getProjectIDFromProjectName(ctx, name)
+	if projErr != nil {
+		return nil, nil, fmt.Errorf("CreateHook was unable to look up the project's projectID, %s", projErr)
+	}
+	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions?api-version=6.0", s.client.owner)
+	in := convertFromHookInput(input, projectID, "")
+	out := new(subscription)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/replace-subscription?view=azure-devops-rest-6.0
+	if len(input.NativeEvents) != 1 {
+		return nil, nil, fmt.Errorf("UpdateHook, Azure requires a single native event %v", input.NativeEvents)
+	}
+	repos := &RepositoryService{client: s.client}
+	projectID, projErr := repos.getProjectIDFromProjectName(ctx, name)
+	if projErr != nil {
+		return nil, nil, fmt.Errorf("UpdateHook was unable to look up the project's projectID, %s", projErr)
+	}
+	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions/%s?api-version=6.0", s.client.owner, id)
+	in := convertFromHookInput(input, projectID, "")
+	in.ID = id
+	out := new(subscription)
+	res, err := s.client.do(ctx, "PUT", endpoint, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/delete?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions/%s?api-version=6.0", s.client.owner, id)
+	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// helper function returns the project-wide subscriptions,
+// excluding subscriptions filtered to a single repository.
+func convertProjectHookList(from []*subscription, projectID string) []*scm.Hook {
+	to := []*scm.Hook{}
+	for _, v := range from {
+		if v.PublisherInputs.ProjectID == projectID && v.PublisherInputs.Repository == "" {
+			to = append(to, convertHook(v))
+		}
+	}
+	return to
+}
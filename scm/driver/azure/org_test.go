@@ -0,0 +1,126 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestOrganizationHookFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/hooks/subscriptions/d455cb11-20a0-4b15-b546-7e9fb9973cc6").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client := NewDefault("ORG", "test_project")
+	got, _, err := client.Organizations.FindHook(context.Background(), "test_project", "d455cb11-20a0-4b15-b546-7e9fb9973cc6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := os.ReadFile("testdata/hook.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects").
+		Reply(200).
+		Type("application/json").
+		File("testdata/projects.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/hooks/subscriptions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/project_hooks.json")
+
+	client := NewDefault("ORG", "test_project")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "test_project", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := os.ReadFile("testdata/project_hooks.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects").
+		Reply(200).
+		Type("application/json").
+		File("testdata/projects.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/_apis/hooks/subscriptions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		NativeEvents: []string{"git.push"},
+		Target:       "http://www.example.com/webhook",
+	}
+
+	client := NewDefault("ORG", "test_project")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "test_project", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := os.ReadFile("testdata/hook.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/_apis/hooks/subscriptions/d455cb11-20a0-4b15-b546-7e9fb9973cc6").
+		Reply(204).
+		Type("application/json")
+
+	client := NewDefault("ORG", "test_project")
+	_, err := client.Organizations.DeleteHook(context.Background(), "test_project", "d455cb11-20a0-4b15-b546-7e9fb9973cc6")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -26,6 +26,10 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -47,6 +51,34 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if input.Method == scm.MergeMethodFastForward {
+		return nil, scm.ErrNotSupported
+	}
+	// azure completes the pull request only if the last merge
+	// source commit matches, so we look it up when the caller
+	// does not provide the expected head commit.
+	sha := input.Sha
+	if sha == "" {
+		pull, res, err := s.Find(ctx, repo, number)
+		if err != nil {
+			return res, err
+		}
+		sha = pull.Sha
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := new(prCompleteInput)
+	in.Status = "completed"
+	in.LastMergeSourceCommit.CommitID = sha
+	in.CompletionOptions.MergeStrategy = convertFromMergeMethod(input.Method)
+	in.CompletionOptions.MergeCommitMessage = scm.JoinCommitMessage(input.CommitTitle, input.CommitMessage)
+	in.CompletionOptions.DeleteSourceBranch = input.DeleteSourceBranch
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, nil)
+	return res, err
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	// Azure DevOps does not support updating pull requests directly.
 	// You can only update the title and description by creating a new pull request.
@@ -86,6 +118,18 @@ type prInput struct {
 	} `json:"reviewers"`
 }
 
+type prCompleteInput struct {
+	Status                string `json:"status"`
+	LastMergeSourceCommit struct {
+		CommitID string `json:"commitId"`
+	} `json:"lastMergeSourceCommit"`
+	CompletionOptions struct {
+		MergeStrategy      string `json:"mergeStrategy,omitempty"`
+		MergeCommitMessage string `json:"mergeCommitMessage,omitempty"`
+		DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	} `json:"completionOptions"`
+}
+
 type pr struct {
 	Repository struct {
 		ID      string `json:"id"`
@@ -196,3 +240,16 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Created: from.CreationDate,
 	}
 }
+
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodMerge:
+		return "noFastForward"
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	default:
+		return ""
+	}
+}
@@ -75,6 +75,14 @@ func TestPullFind(t *testing.T) {
 	}
 }
 
+func TestPullFindDiff(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.PullRequests.FindDiff(context.Background(), "REPOID", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -100,3 +108,45 @@ func TestPullListCommits(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]interface{}{
+			"status": "completed",
+			"lastMergeSourceCommit": map[string]interface{}{
+				"commitId": "6b3f9b7f0e1e8d0f3d8c6a1f7b0d4e6f1d6a3b2c",
+			},
+			"completionOptions": map[string]interface{}{
+				"mergeStrategy":      "squash",
+				"mergeCommitMessage": "squashed",
+				"deleteSourceBranch": true,
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "squashed",
+		Sha:                "6b3f9b7f0e1e8d0f3d8c6a1f7b0d4e6f1d6a3b2c",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	if _, err := client.PullRequests.MergeWithOptions(context.Background(), "REPOID", 1, input); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullMergeWithOptions_FastForward(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	input := &scm.MergeInput{Method: scm.MergeMethodFastForward}
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "REPOID", 1, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -92,12 +92,6 @@ func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *
 		return nil, nil, ProjectRequiredError()
 	}
 	endpoint := fmt.Sprintf("%s/_apis/hooks/subscriptions?api-version=6.0", s.client.owner)
-	in := new(subscription)
-	in.Status = "enabled"
-	in.PublisherID = "tfs"
-	in.ResourceVersion = "1.0"
-	in.ConsumerID = "webHooks"
-	in.ConsumerActionID = "httpRequest"
 	// we do not support scm hookevents, only native events
 	if input.NativeEvents == nil {
 		return nil, nil, fmt.Errorf("CreateHook, You must pass at least one native event")
@@ -105,23 +99,12 @@ func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *
 	if len(input.NativeEvents) > 1 {
 		return nil, nil, fmt.Errorf("CreateHook, Azure only allows the creation of a single hook at a time %v", input.NativeEvents)
 	}
-	in.EventType = input.NativeEvents[0]
 	// publisher
 	projectID, projErr := s.getProjectIDFromProjectName(ctx, s.client.project)
 	if projErr != nil {
 		return nil, nil, fmt.Errorf("CreateHook was unable to look up the project's projectID, %s", projErr)
 	}
-	in.PublisherInputs.ProjectID = projectID
-	in.PublisherInputs.Repository = repo
-	// consumer
-	in.ConsumerInputs.URL = input.Target
-	if input.SkipVerify {
-		in.ConsumerInputs.AcceptUntrustedCerts = "enabled"
-	}
-	// with version 1.0, azure provides incomplete data for issue-comment
-	if in.EventType == "ms.vss-code.git-pullrequest-comment-event" {
-		in.ResourceVersion = "2.0"
-	}
+	in := convertFromHookInput(input, projectID, repo)
 	out := new(subscription)
 	res, err := s.client.do(ctx, "POST", endpoint, in, out)
 	return convertHook(out), res, err
@@ -152,6 +135,122 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
 }
 
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository in the client project.
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// azure devops repositories inherit the visibility of
+	// the project and are always created empty.
+	if input.Description != "" || input.Branch != "" || input.Visibility != scm.VisibilityUndefined ||
+		input.Readme || input.Gitignore != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	projectID, err := s.getProjectIDFromProjectName(ctx, s.client.project)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories?api-version=6.0", s.client.owner, s.client.project)
+	in := new(repositoryInput)
+	in.Name = input.Name
+	in.Project.ID = projectID
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertRepository(out, s.client.owner), res, err
+}
+
+// Fork forks the repository into the named project, or the
+// client project when empty.
+func (s *RepositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if namespace == "" {
+		namespace = s.client.project
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	parent := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, parent)
+	if err != nil {
+		return nil, res, err
+	}
+	projectID, err := s.getProjectIDFromProjectName(ctx, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories?api-version=6.0", s.client.owner, namespace)
+	in := new(repositoryInput)
+	in.Name = parent.Name
+	in.Project.ID = projectID
+	in.ParentRepository = &repositoryRef{ID: parent.ID}
+	in.ParentRepository.Project.ID = parent.Project.ID
+	out := new(repository)
+	res, err = s.client.do(ctx, "POST", endpoint, in, out)
+	return convertRepository(out, s.client.owner), res, err
+}
+
+// Update updates the repository name and default branch.
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Description != "" || input.Visibility != scm.VisibilityUndefined || input.Archived {
+		return nil, nil, scm.ErrNotSupported
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	in := &repositoryUpdateInput{Name: input.Name}
+	if input.Branch != "" {
+		in.DefaultBranch = scm.ExpandRef(input.Branch, "refs/heads")
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, out)
+	return convertRepository(out, s.client.owner), res, err
+}
+
+// Archive returns ErrNotSupported; azure devops does not
+// support archiving repositories.
+func (s *RepositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Unarchive returns ErrNotSupported.
+func (s *RepositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Delete deletes the repository.
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/delete?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	// the delete endpoint only accepts the repository id,
+	// so we look it up by name first.
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	out := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return res, err
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, out.ID)
+	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
+}
+
 // helper function to return the projectID from the project name
 func (s *RepositoryService) getProjectIDFromProjectName(ctx context.Context, projectName string) (string, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/projects/list?view=azure-devops-rest-6.0
@@ -209,6 +308,26 @@ type repository struct {
 	SSHUrl    string `json:"sshUrl"`
 }
 
+type repositoryRef struct {
+	ID      string `json:"id"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+}
+
+type repositoryInput struct {
+	Name    string `json:"name"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+	ParentRepository *repositoryRef `json:"parentRepository,omitempty"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string `json:"name,omitempty"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+}
+
 type subscriptions struct {
 	Count int64           `json:"count"`
 	Value []*subscription `json:"value"`
@@ -227,6 +346,7 @@ type subscription struct {
 		BuildName            string `json:"buildName,omitempty"`
 		BuildParameterized   string `json:"buildParameterized,omitempty"`
 		FeedID               string `json:"feedId,omitempty"`
+		HTTPHeaders          string `json:"httpHeaders,omitempty"`
 		ListID               string `json:"listId,omitempty"`
 		PackageSourceID      string `json:"packageSourceId,omitempty"`
 		Password             string `json:"password,omitempty"`
@@ -299,6 +419,36 @@ func convertRepository(from *repository, owner string) *scm.Repository {
 	}
 }
 
+// helper function to convert the hook input to a service hook
+// subscription. The subscription receives events for every
+// repository in the project when the repository is empty.
+func convertFromHookInput(from *scm.HookInput, projectID, repo string) *subscription {
+	to := new(subscription)
+	to.Status = "enabled"
+	to.PublisherID = "tfs"
+	to.ResourceVersion = "1.0"
+	to.ConsumerID = "webHooks"
+	to.ConsumerActionID = "httpRequest"
+	to.EventType = from.NativeEvents[0]
+	to.PublisherInputs.ProjectID = projectID
+	to.PublisherInputs.Repository = repo
+	to.ConsumerInputs.URL = from.Target
+	if from.SkipVerify {
+		to.ConsumerInputs.AcceptUntrustedCerts = "enabled"
+	}
+	// azure does not sign the payload, so the secret is sent
+	// in a custom http header that is verified by the webhook
+	// parser.
+	if from.Secret != "" {
+		to.ConsumerInputs.HTTPHeaders = "X-Azure-Secret: " + from.Secret
+	}
+	// with version 1.0, azure provides incomplete data for issue-comment
+	if to.EventType == "ms.vss-code.git-pullrequest-comment-event" {
+		to.ResourceVersion = "2.0"
+	}
+	return to
+}
+
 func convertHookList(from []*subscription, projectFilter string, repositoryFilter string) []*scm.Hook {
 	to := []*scm.Hook{}
 	for _, v := range from {
@@ -5,9 +5,13 @@
 package azure
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
@@ -83,6 +87,62 @@ func TestRepositoryHookCreate(t *testing.T) {
 	}
 }
 
+// this test verifies the secret sent when the hook is
+// created is accepted by the webhook parser.
+func TestRepositoryHookCreate_Secret(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects").
+		Reply(201).
+		Type("application/json").
+		File("testdata/projects.json")
+
+	sub := new(subscription)
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/_apis/hooks/subscriptions").
+		Filter(func(req *http.Request) bool {
+			body, _ := io.ReadAll(req.Body)
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			return json.Unmarshal(body, sub) == nil
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Name:         "web",
+		NativeEvents: []string{"git.push"},
+		Target:       "http://www.example.com/webhook",
+		Secret:       "71295b197fa25f4356d2fb9965df3f2379d903d7",
+	}
+
+	client := NewDefault("ORG", "test_project")
+	_, _, err := client.Repositories.CreateHook(context.Background(), "test_project", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	name, value, ok := strings.Cut(sub.ConsumerInputs.HTTPHeaders, ":")
+	if !ok {
+		t.Errorf("Want secret http header, got %q", sub.ConsumerInputs.HTTPHeaders)
+		return
+	}
+
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+
+	s := new(webhookService)
+	_, err = s.Parse(r, func(scm.Webhook) (string, error) {
+		return in.Secret, nil
+	})
+	if err != nil {
+		t.Errorf("Expect secret sent on hook creation to be valid, got %v", err)
+	}
+}
+
 func TestHooksList(t *testing.T) {
 	defer gock.Off()
 
@@ -170,3 +230,134 @@ func TestRepositoryFind(t *testing.T) {
 	}
 
 }
+
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects").
+		Reply(200).
+		Type("application/json").
+		File("testdata/projects.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/test_project/_apis/git/repositories").
+		JSON(map[string]interface{}{
+			"name":    "test_project",
+			"project": map[string]interface{}{"id": "d350c9c0-7749-4ff8-a78f-f9c1f0e56729"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client := NewDefault("ORG", "test_project")
+	got, _, err := client.Repositories.Create(context.Background(), &scm.RepositoryInput{Name: "test_project"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.ID, "91f0d4cb-4c36-49a5-b28d-2d72da089c4d"; got != want {
+		t.Errorf("Want repository id %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryCreate_NotSupported(t *testing.T) {
+	client := NewDefault("ORG", "test_project")
+	input := &scm.RepositoryInput{Name: "test_project", Readme: true}
+	_, _, err := client.Repositories.Create(context.Background(), input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/test_project/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects").
+		Reply(200).
+		Type("application/json").
+		File("testdata/projects.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/test_project/_apis/git/repositories").
+		JSON(map[string]interface{}{
+			"name":    "test_project",
+			"project": map[string]interface{}{"id": "d350c9c0-7749-4ff8-a78f-f9c1f0e56729"},
+			"parentRepository": map[string]interface{}{
+				"id":      "91f0d4cb-4c36-49a5-b28d-2d72da089c4d",
+				"project": map[string]interface{}{"id": "d350c9c0-7749-4ff8-a78f-f9c1f0e56729"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client := NewDefault("ORG", "test_project")
+	_, _, err := client.Repositories.Fork(context.Background(), "test_project", "")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/test_project").
+		JSON(map[string]interface{}{"defaultBranch": "refs/heads/main"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.Update(context.Background(), "test_project", &scm.RepositoryInput{Branch: "main"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.Branch, "main"; got != want {
+		t.Errorf("Want default branch %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryArchive(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Repositories.Archive(context.Background(), "test_project")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/91f0d4cb-4c36-49a5-b28d-2d72da089c4d").
+		Reply(204)
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.Repositories.Delete(context.Background(), "test_project")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
@@ -6,6 +6,10 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +19,419 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
+	// azure comment identifiers are only unique within a
+	// thread, so a comment cannot be found by id alone.
 	return nil, nil, scm.ErrNotSupported
 }
 
+// List returns the comments of the pull request threads that
+// are attached to a file.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	out := new(threadList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertThreadList(out.Value), res, err
 }
 
+// Create creates a new pull request thread, or replies to an
+// existing thread when the input includes the thread id.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Thread != "" {
+		return s.reply(ctx, repo, number, input)
+	}
+	if input.InReplyTo != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &threadInput{
+		Comments: []*threadCommentInput{
+			{Content: input.Body, CommentType: 1},
+		},
+		Status:        "active",
+		ThreadContext: convertFromReviewInput(input),
+	}
+	out := new(thread)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil || len(out.Comments) == 0 {
+		return nil, res, err
+	}
+	to := convertThreadComment(out, out.Comments[0])
+	to.Sha = input.Sha
+	return to, res, nil
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	// azure comment identifiers are only unique within a
+	// thread, so a comment cannot be deleted by id alone.
+	return nil, scm.ErrNotSupported
+}
+
+// Resolve marks the pull request thread as fixed.
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.updateThreadStatus(ctx, repo, number, thread, "fixed")
+}
+
+// Unresolve marks the pull request thread as active.
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.updateThreadStatus(ctx, repo, number, thread, "active")
+}
+
+// Submit casts the vote of the authenticated user. Azure DevOps
+// does not support review bodies or review comments as part of
+// a vote.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-reviewers/create-pull-request-reviewer?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Body != "" || len(input.Comments) != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	var vote int
+	switch input.State {
+	case scm.ReviewStateApproved:
+		vote = 10
+	case scm.ReviewStateChangesRequested:
+		vote = -5
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	self := new(connectionData)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s/_apis/connectionData", s.client.owner), nil, self)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers/%s?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, self.AuthenticatedUser.ID)
+	out := new(reviewer)
+	res, err = s.client.do(ctx, "PUT", endpoint, &reviewerInput{Vote: vote}, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertReviewerSubmission(out)
+	to.Sha = input.Sha
+	return to, res, nil
+}
+
+// ListSubmissions returns the reviewers that voted on the
+// pull request.
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	out, res, err := s.listReviewers(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	to := []*scm.ReviewSubmission{}
+	for _, v := range out.Value {
+		if v.Vote != 0 {
+			to = append(to, convertReviewerSubmission(v))
+		}
+	}
+	return to, res, nil
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	out, res, err := s.listReviewers(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertReviewerList(out.Value), res, nil
+}
+
+// RequestReviewers adds the reviewers to the pull request.
+// Azure DevOps identifies reviewers by id.
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, ids []string) (*scm.Response, error) {
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	var res *scm.Response
+	var err error
+	for _, id := range ids {
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers/%s?api-version=6.0",
+			s.client.owner, s.client.project, repo, number, id)
+		res, err = s.client.do(ctx, "PUT", endpoint, &reviewerInput{}, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, err
+}
+
+// RemoveReviewers removes the reviewers from the pull request.
+// Azure DevOps identifies reviewers by id.
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, ids []string) (*scm.Response, error) {
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	var res *scm.Response
+	var err error
+	for _, id := range ids {
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers/%s?api-version=6.0",
+			s.client.owner, s.client.project, repo, number, id)
+		res, err = s.client.do(ctx, "DELETE", endpoint, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, err
+}
+
+func (s *reviewService) listReviewers(ctx context.Context, repo string, number int) (*reviewerList, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-reviewers/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	out := new(reviewerList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return out, res, err
+}
+
+func (s *reviewService) reply(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-thread-comments/create?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%s/comments?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, input.Thread)
+	in := &threadCommentInput{
+		Content:         input.Body,
+		CommentType:     1,
+		ParentCommentID: input.InReplyTo,
+	}
+	// replies without a parent comment are attached to the
+	// first comment of the thread.
+	if in.ParentCommentID == 0 {
+		in.ParentCommentID = 1
+	}
+	out := new(threadComment)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertThreadComment(&thread{}, out)
+	to.Thread = input.Thread
+	to.Path = input.Path
+	to.Sha = input.Sha
+	return to, res, nil
+}
+
+func (s *reviewService) updateThreadStatus(ctx context.Context, repo string, number int, id, status string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%s?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, id)
+	in := &threadStatusInput{Status: status}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+type threadList struct {
+	Count int       `json:"count"`
+	Value []*thread `json:"value"`
+}
+
+type thread struct {
+	ID              int              `json:"id"`
+	Status          string           `json:"status"`
+	IsDeleted       bool             `json:"isDeleted"`
+	ThreadContext   *threadContext   `json:"threadContext"`
+	Comments        []*threadComment `json:"comments"`
+	PublishedDate   time.Time        `json:"publishedDate"`
+	LastUpdatedDate time.Time        `json:"lastUpdatedDate"`
+}
+
+type threadComment struct {
+	ID              int       `json:"id"`
+	ParentCommentID int       `json:"parentCommentId"`
+	Author          reviewer  `json:"author"`
+	Content         string    `json:"content"`
+	CommentType     string    `json:"commentType"`
+	IsDeleted       bool      `json:"isDeleted"`
+	PublishedDate   time.Time `json:"publishedDate"`
+	LastUpdatedDate time.Time `json:"lastUpdatedDate"`
+}
+
+type threadContext struct {
+	FilePath       string        `json:"filePath"`
+	LeftFileStart  *filePosition `json:"leftFileStart,omitempty"`
+	LeftFileEnd    *filePosition `json:"leftFileEnd,omitempty"`
+	RightFileStart *filePosition `json:"rightFileStart,omitempty"`
+	RightFileEnd   *filePosition `json:"rightFileEnd,omitempty"`
+}
+
+type filePosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
+}
+
+type threadInput struct {
+	Comments      []*threadCommentInput `json:"comments"`
+	Status        string                `json:"status"`
+	ThreadContext *threadContext        `json:"threadContext"`
+}
+
+type threadCommentInput struct {
+	ParentCommentID int    `json:"parentCommentId,omitempty"`
+	Content         string `json:"content"`
+	CommentType     int    `json:"commentType"`
+}
+
+type threadStatusInput struct {
+	Status string `json:"status"`
+}
+
+type connectionData struct {
+	AuthenticatedUser struct {
+		ID string `json:"id"`
+	} `json:"authenticatedUser"`
+}
+
+type reviewerList struct {
+	Count int         `json:"count"`
+	Value []*reviewer `json:"value"`
+}
+
+type reviewer struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	ImageURL    string `json:"imageUrl"`
+	Vote        int    `json:"vote"`
+	IsRequired  bool   `json:"isRequired"`
+}
+
+type reviewerInput struct {
+	Vote int `json:"vote"`
+}
+
+func convertReviewerList(from []*reviewer) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	for _, v := range from {
+		to = append(to, &scm.Reviewer{
+			User:     convertReviewerUser(v),
+			State:    convertVote(v.Vote),
+			Required: v.IsRequired,
+		})
+	}
+	return to
+}
+
+func convertReviewerSubmission(from *reviewer) *scm.ReviewSubmission {
+	return &scm.ReviewSubmission{
+		State:  convertVote(from.Vote),
+		Author: convertReviewerUser(from),
+	}
+}
+
+func convertReviewerUser(from *reviewer) scm.User {
+	return scm.User{
+		ID:     from.ID,
+		Login:  from.UniqueName,
+		Name:   from.DisplayName,
+		Avatar: from.ImageURL,
+	}
+}
+
+// helper function converts the reviewer vote. A vote of 10
+// approves, 5 approves with suggestions, -5 waits for the
+// author and -10 rejects the pull request.
+func convertVote(from int) scm.ReviewState {
+	switch {
+	case from > 0:
+		return scm.ReviewStateApproved
+	case from < 0:
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStatePending
+	}
+}
+
+// helper function converts the review input to an azure
+// thread context. Azure file paths are rooted at the
+// repository root.
+func convertFromReviewInput(from *scm.ReviewInput) *threadContext {
+	to := &threadContext{
+		FilePath: "/" + strings.TrimPrefix(from.Path, "/"),
+	}
+	// the line number defaults to the line for compatibility
+	// with callers that do not set the end line.
+	line := from.EndLine
+	if line == 0 {
+		line = from.Line
+	}
+	start := from.StartLine
+	if start == 0 {
+		start = line
+	}
+	if from.Side == scm.DiffSideBase {
+		to.LeftFileStart = &filePosition{Line: start, Offset: 1}
+		to.LeftFileEnd = &filePosition{Line: line, Offset: 1}
+	} else {
+		to.RightFileStart = &filePosition{Line: start, Offset: 1}
+		to.RightFileEnd = &filePosition{Line: line, Offset: 1}
+	}
+	return to
+}
+
+// helper function converts the pull request threads to a
+// flat list of review comments, skipping threads that are
+// not attached to a file and system generated comments.
+func convertThreadList(from []*thread) []*scm.Review {
+	to := []*scm.Review{}
+	for _, t := range from {
+		if t.IsDeleted || t.ThreadContext == nil {
+			continue
+		}
+		for _, c := range t.Comments {
+			if c.IsDeleted || c.CommentType == "system" {
+				continue
+			}
+			to = append(to, convertThreadComment(t, c))
+		}
+	}
+	return to
+}
+
+func convertThreadComment(t *thread, from *threadComment) *scm.Review {
+	to := &scm.Review{
+		ID:        from.ID,
+		Body:      from.Content,
+		InReplyTo: from.ParentCommentID,
+		Author:    convertReviewerUser(&from.Author),
+		Created:   from.PublishedDate,
+		Updated:   from.LastUpdatedDate,
+	}
+	if t.ID != 0 {
+		to.Thread = strconv.Itoa(t.ID)
+		to.Resolved = t.Status != "" && t.Status != "active" && t.Status != "pending"
+	}
+	if c := t.ThreadContext; c != nil {
+		to.Path = strings.TrimPrefix(c.FilePath, "/")
+		start, end := c.RightFileStart, c.RightFileEnd
+		if end == nil {
+			start, end = c.LeftFileStart, c.LeftFileEnd
+			to.Side = scm.DiffSideBase
+		}
+		if end != nil {
+			to.Line = end.Line
+			to.EndLine = end.Line
+		}
+		if start != nil && end != nil && start.Line != end.Line {
+			to.StartLine = start.Line
+		}
+	}
+	return to
+}
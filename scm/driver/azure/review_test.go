@@ -0,0 +1,214 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Get("/ORG/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"authenticatedUser": {"id": "d6245f20-2af8-44f4-9451-8107cb2767db"}}`)
+
+	gock.New("https://dev.azure.com/").
+		Put("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers/d6245f20-2af8-44f4-9451-8107cb2767db").
+		JSON(map[string]interface{}{"vote": 10}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": "d6245f20-2af8-44f4-9451-8107cb2767db", "displayName": "Normal Paulk", "uniqueName": "fabrikamfiber16@hotmail.com", "vote": 10}`)
+
+	client := NewDefault("ORG", "PROJ")
+	input := &scm.ReviewSubmitInput{State: scm.ReviewStateApproved}
+	got, _, err := client.Reviews.Submit(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.State != scm.ReviewStateApproved {
+		t.Errorf("Want approved review state, got %s", got.State)
+	}
+	if got.Author.Login != "fabrikamfiber16@hotmail.com" {
+		t.Errorf("Want review author fabrikamfiber16@hotmail.com, got %s", got.Author.Login)
+	}
+}
+
+func TestReviewSubmit_Comments(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	input := &scm.ReviewSubmitInput{State: scm.ReviewStateApproved, Body: "lgtm"}
+	_, _, err := client.Reviews.Submit(context.Background(), "REPOID", 1, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviewers.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.ListReviewers(context.Background(), "REPOID", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/reviewers.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers/3b5f0c34-4aec-4bf4-8708-1d36f0dbc468").
+		Reply(204)
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Reviews.RemoveReviewers(context.Background(), "REPOID", 1, []string{"3b5f0c34-4aec-4bf4-8708-1d36f0dbc468"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewer to be removed")
+	}
+}
+
+func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		Reply(200).
+		Type("application/json").
+		File("testdata/threads.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.List(context.Background(), "REPOID", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := os.ReadFile("testdata/threads.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		JSON(map[string]interface{}{
+			"comments": []map[string]interface{}{
+				{"content": "Should we add a comment about what this value means?", "commentType": 1},
+			},
+			"status": "active",
+			"threadContext": map[string]interface{}{
+				"filePath":      "/new_feature.cpp",
+				"leftFileStart": map[string]interface{}{"line": 30, "offset": 1},
+				"leftFileEnd":   map[string]interface{}{"line": 32, "offset": 1},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 148, "status": "active", "threadContext": {"filePath": "/new_feature.cpp", "leftFileStart": {"line": 30, "offset": 1}, "leftFileEnd": {"line": 32, "offset": 1}}, "comments": [{"id": 1, "parentCommentId": 0, "content": "Should we add a comment about what this value means?", "commentType": "text"}]}`)
+
+	input := &scm.ReviewInput{
+		Body:      "Should we add a comment about what this value means?",
+		Path:      "new_feature.cpp",
+		StartLine: 30,
+		Line:      32,
+		Side:      scm.DiffSideBase,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Create(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Thread != "148" || got.Side != scm.DiffSideBase || got.StartLine != 30 || got.Line != 32 {
+		t.Errorf("Unexpected review comment in thread %s at %s %d-%d", got.Thread, got.Side, got.StartLine, got.Line)
+	}
+}
+
+func TestReviewCreateReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/148/comments").
+		JSON(map[string]interface{}{
+			"parentCommentId": 1,
+			"content":         "No, I think this is clear.",
+			"commentType":     1,
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 2, "parentCommentId": 1, "content": "No, I think this is clear.", "commentType": "text"}`)
+
+	input := &scm.ReviewInput{
+		Body:   "No, I think this is clear.",
+		Thread: "148",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Create(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.ID != 2 || got.InReplyTo != 1 || got.Thread != "148" {
+		t.Errorf("Unexpected reply %d to %d in thread %s", got.ID, got.InReplyTo, got.Thread)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/148").
+		JSON(map[string]interface{}{"status": "fixed"}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 148, "status": "fixed"}`)
+
+	client := NewDefault("ORG", "PROJ")
+	if _, err := client.Reviews.Resolve(context.Background(), "REPOID", 1, "148"); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review thread to be resolved")
+	}
+}
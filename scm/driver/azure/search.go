@@ -0,0 +1,117 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+// Code returns the files matching the search query. The
+// search api only returns the character offsets of the
+// matches, so the results do not include fragments.
+func (s *searchService) Code(ctx context.Context, opts scm.SearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/search/code-search-results/fetch-code-search-results?view=azure-devops-rest-6.0
+	project := s.client.project
+	if opts.Namespace != "" {
+		project = opts.Namespace
+	}
+	endpoint := fmt.Sprintf("%s%s/_apis/search/codesearchresults?api-version=6.0", searchAddress(s.client.BaseURL), s.client.owner)
+	if project != "" {
+		endpoint = fmt.Sprintf("%s%s/%s/_apis/search/codesearchresults?api-version=6.0", searchAddress(s.client.BaseURL), s.client.owner, project)
+	}
+	in := &codeSearchInput{
+		SearchText: opts.Query,
+		Top:        opts.Size,
+	}
+	if in.Top == 0 {
+		in.Top = 25
+	}
+	if opts.Page > 1 {
+		in.Skip = (opts.Page - 1) * in.Top
+	}
+	if opts.Repo != "" {
+		in.Filters = map[string][]string{
+			"Repository": {opts.Repo},
+		}
+	}
+	out := new(codeSearchResults)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertCodeResultList(out.Results), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.SearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchOptions) ([]*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// helper function returns the address of the search api,
+// which azure devops services hosts on a separate domain.
+func searchAddress(base *url.URL) string {
+	if base.Host == "dev.azure.com" {
+		return "https://almsearch.dev.azure.com/"
+	}
+	return base.String()
+}
+
+type codeSearchInput struct {
+	SearchText string              `json:"searchText"`
+	Skip       int                 `json:"$skip"`
+	Top        int                 `json:"$top"`
+	Filters    map[string][]string `json:"filters,omitempty"`
+}
+
+type codeSearchResults struct {
+	Count   int                 `json:"count"`
+	Results []*codeSearchResult `json:"results"`
+}
+
+type codeSearchResult struct {
+	FileName   string `json:"fileName"`
+	Path       string `json:"path"`
+	Repository struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"repository"`
+	Versions []struct {
+		BranchName string `json:"branchName"`
+		ChangeID   string `json:"changeId"`
+	} `json:"versions"`
+}
+
+func convertCodeResultList(from []*codeSearchResult) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+func convertCodeResult(from *codeSearchResult) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Repo:   from.Repository.Name,
+		RepoID: from.Repository.ID,
+		Path:   strings.TrimPrefix(from.Path, "/"),
+	}
+	if len(from.Versions) != 0 {
+		to.Ref = scm.TrimRef(from.Versions[0].BranchName)
+	}
+	return to
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://almsearch.dev.azure.com").
+		Post("/ORG/PROJ/_apis/search/codesearchresults").
+		JSON(map[string]interface{}{
+			"searchText": "github.com/pkg/errors",
+			"$skip":      25,
+			"$top":       25,
+			"filters": map[string][]string{
+				"Repository": {"REPONAME"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_code.json")
+
+	opts := scm.SearchOptions{
+		Query: "github.com/pkg/errors",
+		Repo:  "REPONAME",
+		Page:  2,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := os.ReadFile("testdata/search_code.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Search.Issues(context.Background(), scm.SearchOptions{Query: "crash"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload and headers that azure
+// devops service hooks send, which can be used to test
+// webhook handlers without a connection to azure devops.
+// Azure does not sign the payload, and instead the secret
+// is sent in a custom http header. ErrNotSupported is
+// returned for other hook types and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var payload map[string]interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		payload = simulatePushHook(v)
+	case *scm.PullRequestHook:
+		event, ok := simulatePullRequestEvent(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		payload = simulatePullRequestHook(v, event)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+	payload["id"] = hook.Meta().GUID
+	payload["publisherId"] = "tfs"
+	payload["scope"] = "all"
+	payload["resourceVersion"] = "1.0"
+	payload["createdDate"] = time.Now().UTC()
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if secret != "" {
+		req.Header.Set("X-Azure-Secret", secret)
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) map[string]interface{} {
+	after := src.After
+	if after == "" {
+		after = src.Commit.Sha
+	}
+	commits := []interface{}{}
+	for _, c := range src.Commits {
+		commits = append(commits, map[string]interface{}{
+			"commitId": c.Sha,
+			"comment":  c.Message,
+			"url":      c.Link,
+			"author": map[string]interface{}{
+				"name":  c.Author.Name,
+				"email": c.Author.Email,
+				"date":  c.Author.Date,
+			},
+			"committer": map[string]interface{}{
+				"name":  c.Committer.Name,
+				"email": c.Committer.Email,
+				"date":  c.Committer.Date,
+			},
+		})
+	}
+	return map[string]interface{}{
+		"eventType": "git.push",
+		"resource": map[string]interface{}{
+			"commits":  commits,
+			"pushedBy": simulateIdentity(src.Sender.Login, &src.Sender),
+			"refUpdates": []interface{}{
+				map[string]interface{}{
+					"name":        src.Ref,
+					"oldObjectId": src.Before,
+					"newObjectId": after,
+				},
+			},
+			"repository": simulateRepository(&src.Repo),
+		},
+	}
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, event string) map[string]interface{} {
+	pull := &src.PullRequest
+	status := "active"
+	var closed interface{}
+	switch {
+	case pull.Merged || src.Action == scm.ActionMerge:
+		status, closed = "completed", pull.Updated
+	case pull.Closed || src.Action == scm.ActionClose:
+		status, closed = "abandoned", pull.Updated
+	}
+	return map[string]interface{}{
+		"eventType": event,
+		"resource": map[string]interface{}{
+			"repository":    simulateRepository(&src.Repo),
+			"pullRequestId": pull.Number,
+			"status":        status,
+			"createdBy":     simulateIdentity(src.Sender.Login, &pull.Author),
+			"creationDate":  pull.Created,
+			"closedDate":    closed,
+			"title":         pull.Title,
+			"description":   pull.Body,
+			"sourceRefName": scm.ExpandRef(pull.Source, "refs/heads"),
+			"targetRefName": scm.ExpandRef(pull.Target, "refs/heads"),
+			"mergeStatus":   "succeeded",
+			"lastMergeSourceCommit": map[string]interface{}{
+				"commitId": pull.Sha,
+			},
+			"lastMergeTargetCommit": map[string]interface{}{
+				"commitId": pull.Base.Sha,
+			},
+			"lastMergeCommit": map[string]interface{}{
+				"commitId": pull.Merge,
+			},
+			"url": pull.Link,
+		},
+	}
+}
+
+func simulateRepository(src *scm.Repository) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            src.ID,
+		"name":          src.Name,
+		"defaultBranch": scm.ExpandRef(src.Branch, "refs/heads"),
+		"project": map[string]interface{}{
+			"name":  src.Namespace,
+			"state": "wellFormed",
+		},
+		"remoteUrl": src.Clone,
+		"webUrl":    src.Link,
+		"sshUrl":    src.CloneSSH,
+	}
+}
+
+// helper function returns the native identity. Azure
+// identifies users by a unique id, which is mapped to
+// the user login by the webhook parser.
+func simulateIdentity(id string, src *scm.User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"displayName": src.Name,
+		"uniqueName":  src.Email,
+		"imageUrl":    src.Avatar,
+	}
+}
+
+// helper function returns the native pull request event.
+// Azure does not send a dedicated event when a pull request
+// is abandoned, and instead sends an update event.
+func simulatePullRequestEvent(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen, scm.ActionCreate:
+		return "git.pullrequest.created", true
+	case scm.ActionSync, scm.ActionUpdate, scm.ActionClose:
+		return "git.pullrequest.updated", true
+	case scm.ActionMerge:
+		return "git.pullrequest.merged", true
+	default:
+		return "", false
+	}
+}
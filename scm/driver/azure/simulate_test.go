@@ -0,0 +1,138 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "278d5cd2-584d-4b63-824a-2ba458937249",
+	Namespace: "Fabrikam-Fiber-Git",
+	Name:      "Fabrikam-Fiber-Git",
+	Branch:    "master",
+	Clone:     "https://dev.azure.com/fabrikam-fiber-inc/Fabrikam-Fiber-Git/_git/Fabrikam-Fiber-Git",
+	Link:      "https://dev.azure.com/fabrikam-fiber-inc/Fabrikam-Fiber-Git/_git/Fabrikam-Fiber-Git",
+}
+
+var simulateSender = scm.User{
+	Login:  "00067FFED5C7AF52@Live.com",
+	Name:   "Jamal Hartnett",
+	Email:  "fabrikamfiber4@hotmail.com",
+	Avatar: "https://dev.azure.com/fabrikam-fiber-inc/_api/_common/identityImage?id=54d125f7-69f7-4191-904f-c5b96b6261c8",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	pusher := scm.Signature{
+		Login:  "00067FFED5C7AF52@Live.com",
+		Name:   "Jamal Hartnett",
+		Email:  "fabrikamfiber4@hotmail.com",
+		Avatar: "https://dev.azure.com/fabrikam-fiber-inc/_api/_common/identityImage?id=54d125f7-69f7-4191-904f-c5b96b6261c8",
+	}
+	signature := scm.Signature{
+		Login: "Jamal Hartnett",
+		Name:  "Jamal Hartnett",
+		Email: "fabrikamfiber4@hotmail.com",
+		Date:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "aad331d8d3b131fa9ae03cf5e53965b51942618a",
+			After:  "33b55f7cb7e7e245323987634f960cf4a6e6bc74",
+			Commit: scm.Commit{
+				Sha:       "33b55f7cb7e7e245323987634f960cf4a6e6bc74",
+				Author:    pusher,
+				Committer: pusher,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "33b55f7cb7e7e245323987634f960cf4a6e6bc74",
+					Message:   "Fixed bug in web.config file",
+					Link:      "https://dev.azure.com/fabrikam-fiber-inc/DefaultCollection/_git/Fabrikam-Fiber-Git/commit/33b55f7cb7e7e245323987634f960cf4a6e6bc74",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "my first pull request",
+				Body:   " - test2\r\n",
+				Sha:    "53d54ac915144006c2c9e90d2c7d3880920db49c",
+				Ref:    "refs/heads/mytopic",
+				Source: "mytopic",
+				Target: "master",
+				Link:   "https://dev.azure.com/fabrikam/DefaultCollection/_apis/repos/git/repositories/4bc14d40-c903-45e2-872e-0462c7748079/pullRequests/1",
+				Merged: true,
+				Author: scm.User{
+					Login:  "Jamal Hartnett",
+					Name:   "Jamal Hartnett",
+					Email:  "fabrikamfiber4@hotmail.com",
+					Avatar: "https://dev.azure.com/fabrikam-fiber-inc/_api/_common/identityImage?id=54d125f7-69f7-4191-904f-c5b96b6261c8",
+				},
+				Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			Repo: scm.Repository{
+				ID:        "278d5cd2-584d-4b63-824a-2ba458937249",
+				Namespace: "Fabrikam-Fiber-Git",
+				Name:      "Fabrikam-Fiber-Git",
+				Clone:     "https://dev.azure.com/fabrikam-fiber-inc/Fabrikam-Fiber-Git/_git/Fabrikam-Fiber-Git",
+				CloneSSH:  "git@ssh.dev.azure.com:v3/fabrikam-fiber-inc/Fabrikam-Fiber-Git/Fabrikam-Fiber-Git",
+				Link:      "https://dev.azure.com/fabrikam-fiber-inc/Fabrikam-Fiber-Git/_git/Fabrikam-Fiber-Git",
+			},
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
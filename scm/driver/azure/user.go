@@ -29,3 +29,19 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *userService) ListKeys(context.Context, scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) CreateKey(context.Context, *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) DeleteKey(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListGPGKeys(context.Context, scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
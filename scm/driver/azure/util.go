@@ -7,6 +7,7 @@ package azure
 import (
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -21,3 +22,25 @@ func encodeListOptions(opts scm.ListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("searchCriteria.itemPath", path)
+	if opts.Ref != "" {
+		params.Set("searchCriteria.itemVersion.version", opts.Ref)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("searchCriteria.fromDate", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("searchCriteria.toDate", opts.Until.UTC().Format(time.RFC3339))
+	}
+	if opts.Size != 0 {
+		params.Set("searchCriteria.$top", strconv.Itoa(opts.Size))
+		if opts.Page > 1 {
+			params.Set("searchCriteria.$skip", strconv.Itoa((opts.Page-1)*opts.Size))
+		}
+	}
+	params.Set("api-version", "6.0")
+	return params.Encode()
+}
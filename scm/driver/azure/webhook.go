@@ -5,10 +5,12 @@
 package azure
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -34,6 +36,7 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 	eventType := unstructuredJSON["eventType"].(string)
 
+	var hook scm.Webhook
 	switch eventType {
 	case "git.push":
 		// https://docs.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.push
@@ -43,7 +46,7 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 			return nil, err
 		}
 		dst := convertPushHook(src)
-		return dst, nil
+		hook = dst
 	case "git.pullrequest.created":
 		// https://docs.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.pullrequest.created
 		src := new(createPullRequestHook)
@@ -53,7 +56,7 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		}
 		dst := convertCreatePullRequestHook(src)
 		dst.Action = scm.ActionCreate
-		return dst, nil
+		hook = dst
 	case "git.pullrequest.updated":
 		// https://docs.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.pullrequest.updated
 		src := new(updatePullRequestHook)
@@ -63,7 +66,7 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		}
 		dst := convertUpdatePullRequestHook(src)
 		dst.Action = scm.ActionUpdate
-		return dst, nil
+		hook = dst
 	case "git.pullrequest.merged":
 		// https://docs.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.pullrequest.merged
 		src := new(mergePullRequestHook)
@@ -73,7 +76,7 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		}
 		dst := convertMergePullRequestHook(src)
 		dst.Action = scm.ActionMerge
-		return dst, nil
+		hook = dst
 	case "ms.vss-code.git-pullrequest-comment-event":
 		src := new(issueCommentPullRequestHook)
 		err := json.Unmarshal(data, src)
@@ -82,10 +85,88 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		}
 		dst := convertIssueCommentHook(src)
 		dst.Action = getIssueCommentAction(src)
-		return dst, nil
+		hook = dst
+	case "git.pullrequest.merge-attempted":
+		// https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.pullrequest.merged
+		src := new(mergePullRequestHook)
+		err := json.Unmarshal(data, src)
+		if err != nil {
+			return nil, err
+		}
+		dst := convertMergePullRequestHook(src)
+		dst.Action = scm.ActionMerge
+		// the merge attempt may fail, for example, when the
+		// pull request has merge conflicts.
+		if src.Resource.MergeStatus != "succeeded" {
+			dst.Action = scm.ActionUpdate
+			dst.PullRequest.Merged = false
+		}
+		hook = dst
+	case "build.complete":
+		// https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#build.complete
+		hook, err = parseBuildHook(data)
+	case "ms.vss-pipelines.run-state-changed-event":
+		// https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#run-state-changed
+		hook, err = parseRunStateChangedHook(data)
+	case "workitem.created", "workitem.updated", "workitem.deleted", "workitem.restored":
+		// https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#workitem.created
+		hook, err = parseWorkItemHook(eventType, data)
+	case "git.repo.created", "git.repo.deleted", "git.repo.renamed":
+		// https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.repo.created
+		hook, err = parseRepositoryHook(eventType, data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = parseRawHook(req, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	guid, _ := unstructuredJSON["id"].(string)
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverAzure,
+		GUID:     guid,
+		Event:    eventType,
+		Received: time.Now(),
+	})
+
+	// get the shared secret to verify the payload
+	// authenticity. If no secret is provided, no
+	// validation is performed.
+	key, err := fn(hook)
+	if err != nil {
+		return hook, err
+	} else if key == "" {
+		return hook, nil
+	}
+
+	// compare the secrets in constant time to prevent
+	// leaking the secret through timing attacks.
+	if subtle.ConstantTimeCompare([]byte(key), []byte(extractSecret(req))) != 1 {
+		return hook, scm.ErrSignatureInvalid
+	}
+
+	return hook, nil
+}
+
+// helper function returns the shared secret included in the
+// service hook request. Azure service hooks can be configured
+// with basic authentication credentials, in which case the
+// password is the secret, or with custom http headers. The
+// secret is not read from the url query parameters, which
+// are written to access logs.
+func extractSecret(req *http.Request) string {
+	if _, password, ok := req.BasicAuth(); ok {
+		return password
 	}
+	return req.Header.Get("X-Azure-Secret")
 }
 
 func getIssueCommentAction(src *issueCommentPullRequestHook) scm.Action {
@@ -341,6 +422,233 @@ func convertIssueCommentHook(src *issueCommentPullRequestHook) *scm.IssueComment
 	return dst
 }
 
+func parseBuildHook(data []byte) (scm.Webhook, error) {
+	src := new(buildHook)
+	if err := json.Unmarshal(data, src); err != nil {
+		return nil, err
+	}
+	return &scm.WorkflowRunHook{
+		Action: scm.ActionComplete,
+		Repo: scm.Repository{
+			ID:        src.Resource.Repository.ID,
+			Name:      src.Resource.Repository.Name,
+			Namespace: src.Resource.Project.Name,
+		},
+		WorkflowRun: scm.WorkflowRun{
+			ID:      src.Resource.ID,
+			Name:    src.Resource.Definition.Name,
+			Number:  int(src.Resource.ID),
+			Event:   src.Resource.Reason,
+			Sha:     src.Resource.SourceVersion,
+			Branch:  scm.TrimRef(src.Resource.SourceBranch),
+			State:   convertBuildResult(src.Resource.Status, src.Resource.Result),
+			Link:    src.Resource.Links.Web.Href,
+			Created: src.Resource.StartTime,
+			Updated: src.Resource.FinishTime,
+		},
+		Sender: convertIdentity(src.Resource.RequestedFor),
+	}, nil
+}
+
+func parseRunStateChangedHook(data []byte) (scm.Webhook, error) {
+	src := new(runStateChangedHook)
+	if err := json.Unmarshal(data, src); err != nil {
+		return nil, err
+	}
+	run := src.Resource.Run
+	self := run.Resources.Repositories.Self
+	dst := &scm.WorkflowRunHook{
+		Action: scm.ActionStart,
+		Repo: scm.Repository{
+			ID: self.Repository.ID,
+		},
+		WorkflowRun: scm.WorkflowRun{
+			ID:      run.ID,
+			Name:    src.Resource.Pipeline.Name,
+			Number:  int(run.ID),
+			Sha:     self.Version,
+			Branch:  scm.TrimRef(self.RefName),
+			State:   convertBuildResult(run.State, run.Result),
+			Link:    run.Links.Web.Href,
+			Created: run.CreatedDate,
+			Updated: run.FinishedDate,
+		},
+	}
+	if run.State == "completed" {
+		dst.Action = scm.ActionComplete
+	}
+	return dst, nil
+}
+
+func parseWorkItemHook(eventType string, data []byte) (scm.Webhook, error) {
+	src := new(workItemHook)
+	if err := json.Unmarshal(data, src); err != nil {
+		return nil, err
+	}
+	// the updated event resource includes the changed
+	// fields and the full work item in the revision.
+	item := new(workItem)
+	update := new(workItemUpdate)
+	if eventType == "workitem.updated" {
+		if err := json.Unmarshal(src.Resource, update); err != nil {
+			return nil, err
+		}
+		item = &update.Revision
+	} else if err := json.Unmarshal(src.Resource, item); err != nil {
+		return nil, err
+	}
+	dst := &scm.IssueHook{
+		Repo: scm.Repository{
+			ID:        src.ResourceContainers.Project.ID,
+			Namespace: item.Fields.TeamProject,
+		},
+		Issue:  convertWorkItem(item),
+		Sender: convertIdentity(item.Fields.ChangedBy.identity),
+	}
+	switch eventType {
+	case "workitem.created":
+		dst.Action = scm.ActionOpen
+	case "workitem.deleted":
+		dst.Action = scm.ActionDelete
+	case "workitem.restored":
+		dst.Action = scm.ActionReopen
+	default:
+		dst.Action = scm.ActionUpdate
+		if update.RevisedBy.ID != "" {
+			dst.Sender = convertIdentity(update.RevisedBy)
+		}
+		if state := update.Fields.State; state.NewValue != state.OldValue {
+			switch {
+			case isWorkItemClosed(state.NewValue):
+				dst.Action = scm.ActionClose
+			case isWorkItemClosed(state.OldValue):
+				dst.Action = scm.ActionReopen
+			}
+		}
+	}
+	return dst, nil
+}
+
+func parseRepositoryHook(eventType string, data []byte) (scm.Webhook, error) {
+	src := new(repositoryHook)
+	if err := json.Unmarshal(data, src); err != nil {
+		return nil, err
+	}
+	dst := &scm.RepositoryHook{
+		Repo: scm.Repository{
+			ID:        src.Resource.Repository.ID,
+			Name:      src.Resource.Repository.Name,
+			Namespace: src.Resource.Repository.Project.Name,
+			Branch:    scm.TrimRef(src.Resource.Repository.DefaultBranch),
+			Clone:     src.Resource.Repository.RemoteURL,
+			CloneSSH:  src.Resource.Repository.SSHURL,
+			Link:      src.Resource.Repository.WebURL,
+		},
+		Sender: convertIdentity(src.Resource.InitiatedBy),
+	}
+	switch eventType {
+	case "git.repo.created":
+		dst.Action = scm.ActionCreate
+	case "git.repo.deleted":
+		// the deleted event does not include the repository
+		// details, only the repository and project identifiers.
+		dst.Action = scm.ActionDelete
+		dst.Repo.ID = src.Resource.RepositoryID
+		dst.Repo.Name = src.Resource.RepositoryName
+	case "git.repo.renamed":
+		dst.Action = scm.ActionUpdate
+		dst.Previous = dst.Repo
+		dst.Previous.Name = src.Resource.OldName
+	}
+	return dst, nil
+}
+
+func parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if repo := src.Resource.Repository; repo != nil {
+		dst.Repo = scm.Repository{
+			ID:        repo.ID,
+			Name:      repo.Name,
+			Namespace: repo.Project.Name,
+			Branch:    scm.TrimRef(repo.DefaultBranch),
+			Clone:     repo.RemoteURL,
+			CloneSSH:  repo.SSHURL,
+			Link:      repo.WebURL,
+		}
+	}
+	return dst, nil
+}
+
+func convertWorkItem(src *workItem) scm.Issue {
+	dst := scm.Issue{
+		Number:  src.ID,
+		Title:   src.Fields.Title,
+		Body:    src.Fields.Description,
+		Link:    src.Links.HTML.Href,
+		Closed:  isWorkItemClosed(src.Fields.State),
+		Author:  convertIdentity(src.Fields.CreatedBy.identity),
+		Created: src.Fields.CreatedDate,
+		Updated: src.Fields.ChangedDate,
+	}
+	for _, tag := range strings.Split(src.Fields.Tags, ";") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			dst.Labels = append(dst.Labels, tag)
+		}
+	}
+	return dst
+}
+
+func convertIdentity(src identity) scm.User {
+	return scm.User{
+		Login:  src.ID,
+		Name:   src.DisplayName,
+		Email:  src.UniqueName,
+		Avatar: src.ImageURL,
+	}
+}
+
+// helper function returns true if the work item state is
+// a closed state. Work item states are defined by the
+// process template, so only the states used by the
+// default templates are considered.
+func isWorkItemClosed(state string) bool {
+	switch state {
+	case "Closed", "Done", "Removed", "Resolved":
+		return true
+	default:
+		return false
+	}
+}
+
+// helper function converts the build or pipeline run
+// status and result to a state.
+func convertBuildResult(status, result string) scm.State {
+	switch result {
+	case "succeeded":
+		return scm.StateSuccess
+	case "partiallySucceeded", "failed":
+		return scm.StateFailure
+	case "canceled", "stopped":
+		return scm.StateCanceled
+	}
+	switch status {
+	case "notStarted", "postponed":
+		return scm.StatePending
+	case "inProgress", "cancelling":
+		return scm.StateRunning
+	default:
+		return scm.StateUnknown
+	}
+}
+
 type pushHook struct {
 	CreatedDate     string `json:"createdDate"`
 	DetailedMessage struct {
@@ -787,3 +1095,196 @@ type issueCommentPullRequestHook struct {
 	ResourceVersion string `json:"resourceVersion"`
 	Scope           string `json:"scope"`
 }
+
+type identity struct {
+	DisplayName string `json:"displayName"`
+	ID          string `json:"id"`
+	ImageURL    string `json:"imageUrl"`
+	UniqueName  string `json:"uniqueName"`
+}
+
+// identityField is a work item identity field. Older service
+// hook resource versions encode identities as a string in the
+// "Display Name <email>" format.
+type identityField struct {
+	identity
+}
+
+func (f *identityField) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return json.Unmarshal(data, &f.identity)
+	}
+	f.DisplayName = s
+	if i := strings.LastIndex(s, " <"); i != -1 && strings.HasSuffix(s, ">") {
+		f.DisplayName = s[:i]
+		f.UniqueName = s[i+2 : len(s)-1]
+	}
+	return nil
+}
+
+type rawHook struct {
+	ID        string `json:"id"`
+	EventType string `json:"eventType"`
+	Resource  struct {
+		Repository *struct {
+			ID      string `json:"id"`
+			Name    string `json:"name"`
+			Project struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"project"`
+			DefaultBranch string `json:"defaultBranch"`
+			RemoteURL     string `json:"remoteUrl"`
+			SSHURL        string `json:"sshUrl"`
+			WebURL        string `json:"webUrl"`
+		} `json:"repository"`
+	} `json:"resource"`
+}
+
+type buildHook struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		ID            int64     `json:"id"`
+		BuildNumber   string    `json:"buildNumber"`
+		Status        string    `json:"status"`
+		Result        string    `json:"result"`
+		StartTime     time.Time `json:"startTime"`
+		FinishTime    time.Time `json:"finishTime"`
+		Reason        string    `json:"reason"`
+		SourceBranch  string    `json:"sourceBranch"`
+		SourceVersion string    `json:"sourceVersion"`
+		RequestedFor  identity  `json:"requestedFor"`
+		Definition    struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"definition"`
+		Repository struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"repository"`
+		Project struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"project"`
+		Links struct {
+			Web struct {
+				Href string `json:"href"`
+			} `json:"web"`
+		} `json:"_links"`
+	} `json:"resource"`
+}
+
+type runStateChangedHook struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		Run struct {
+			ID           int64     `json:"id"`
+			Name         string    `json:"name"`
+			State        string    `json:"state"`
+			Result       string    `json:"result"`
+			CreatedDate  time.Time `json:"createdDate"`
+			FinishedDate time.Time `json:"finishedDate"`
+			Resources    struct {
+				Repositories struct {
+					Self struct {
+						Repository struct {
+							ID   string `json:"id"`
+							Type string `json:"type"`
+						} `json:"repository"`
+						RefName string `json:"refName"`
+						Version string `json:"version"`
+					} `json:"self"`
+				} `json:"repositories"`
+			} `json:"resources"`
+			Links struct {
+				Web struct {
+					Href string `json:"href"`
+				} `json:"web"`
+			} `json:"_links"`
+		} `json:"run"`
+		Pipeline struct {
+			ID     int64  `json:"id"`
+			Name   string `json:"name"`
+			Folder string `json:"folder"`
+		} `json:"pipeline"`
+	} `json:"resource"`
+}
+
+type workItem struct {
+	ID     int `json:"id"`
+	Rev    int `json:"rev"`
+	Fields struct {
+		TeamProject string        `json:"System.TeamProject"`
+		Title       string        `json:"System.Title"`
+		Description string        `json:"System.Description"`
+		State       string        `json:"System.State"`
+		Tags        string        `json:"System.Tags"`
+		CreatedBy   identityField `json:"System.CreatedBy"`
+		ChangedBy   identityField `json:"System.ChangedBy"`
+		CreatedDate time.Time     `json:"System.CreatedDate"`
+		ChangedDate time.Time     `json:"System.ChangedDate"`
+	} `json:"fields"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"_links"`
+}
+
+type workItemHook struct {
+	EventType string `json:"eventType"`
+	// the resource is a work item, except for the
+	// workitem.updated event, where the resource is
+	// a work item update.
+	Resource           json.RawMessage `json:"resource"`
+	ResourceContainers struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	} `json:"resourceContainers"`
+}
+
+type workItemUpdate struct {
+	ID         int      `json:"id"`
+	WorkItemID int      `json:"workItemId"`
+	Rev        int      `json:"rev"`
+	RevisedBy  identity `json:"revisedBy"`
+	Revision   workItem `json:"revision"`
+	Fields     struct {
+		State struct {
+			OldValue string `json:"oldValue"`
+			NewValue string `json:"newValue"`
+		} `json:"System.State"`
+	} `json:"fields"`
+}
+
+type repositoryHook struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		Repository struct {
+			ID      string `json:"id"`
+			Name    string `json:"name"`
+			URL     string `json:"url"`
+			Project struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"project"`
+			DefaultBranch string `json:"defaultBranch"`
+			RemoteURL     string `json:"remoteUrl"`
+			SSHURL        string `json:"sshUrl"`
+			WebURL        string `json:"webUrl"`
+		} `json:"repository"`
+		// the below fields are only included in the
+		// git.repo.deleted event.
+		ProjectID      string `json:"projectId"`
+		RepositoryID   string `json:"repositoryId"`
+		RepositoryName string `json:"repositoryName"`
+		// the below fields are only included in the
+		// git.repo.renamed event.
+		OldName     string   `json:"oldName"`
+		NewName     string   `json:"newName"`
+		InitiatedBy identity `json:"initiatedBy"`
+	} `json:"resource"`
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -28,6 +29,12 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// push hook from a project-wide subscription
+		{
+			before: "testdata/webhooks/project_push.json",
+			after:  "testdata/webhooks/project_push.json.golden",
+			obj:    new(scm.PushHook),
+		},
 		// pull request events
 		// pull request created
 		{
@@ -65,6 +72,54 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/issue_comment_delete.json.golden",
 			obj:    new(scm.IssueCommentHook),
 		},
+		// pull request merge attempted
+		{
+			before: "testdata/webhooks/pr_merge_attempted.json",
+			after:  "testdata/webhooks/pr_merge_attempted.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// build complete
+		{
+			before: "testdata/webhooks/build_complete.json",
+			after:  "testdata/webhooks/build_complete.json.golden",
+			obj:    new(scm.WorkflowRunHook),
+		},
+		// pipeline run state changed
+		{
+			before: "testdata/webhooks/pipeline_run_completed.json",
+			after:  "testdata/webhooks/pipeline_run_completed.json.golden",
+			obj:    new(scm.WorkflowRunHook),
+		},
+		// work item created
+		{
+			before: "testdata/webhooks/workitem_created.json",
+			after:  "testdata/webhooks/workitem_created.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// work item updated
+		{
+			before: "testdata/webhooks/workitem_updated.json",
+			after:  "testdata/webhooks/workitem_updated.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		// repository created
+		{
+			before: "testdata/webhooks/repo_created.json",
+			after:  "testdata/webhooks/repo_created.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// repository deleted
+		{
+			before: "testdata/webhooks/repo_deleted.json",
+			after:  "testdata/webhooks/repo_deleted.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// repository renamed
+		{
+			before: "testdata/webhooks/repo_renamed.json",
+			after:  "testdata/webhooks/repo_renamed.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -80,7 +135,8 @@ func TestWebhooks(t *testing.T) {
 		}
 
 		buf := bytes.NewBuffer(before)
-		r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", buf)
+		r, _ := http.NewRequest("GET", "/", buf)
+		r.Header.Set("X-Azure-Secret", "71295b197fa25f4356d2fb9965df3f2379d903d7")
 
 		s := new(webhookService)
 		o, err := s.Parse(r, secretFunc)
@@ -95,7 +151,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 
@@ -120,6 +176,169 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhookSecret(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*http.Request)
+		err   error
+	}{
+		{
+			name: "basic auth",
+			setup: func(r *http.Request) {
+				r.SetBasicAuth("drone", "71295b197fa25f4356d2fb9965df3f2379d903d7")
+			},
+		},
+		{
+			name: "header",
+			setup: func(r *http.Request) {
+				r.Header.Set("X-Azure-Secret", "71295b197fa25f4356d2fb9965df3f2379d903d7")
+			},
+		},
+		{
+			name: "basic auth invalid",
+			setup: func(r *http.Request) {
+				r.SetBasicAuth("drone", "a4f7c3e7bd0c9b35b2b1e4a1e2f2d0cb43f3e4a7")
+			},
+			err: scm.ErrSignatureInvalid,
+		},
+		{
+			name: "header invalid",
+			setup: func(r *http.Request) {
+				r.Header.Set("X-Azure-Secret", "a4f7c3e7bd0c9b35b2b1e4a1e2f2d0cb43f3e4a7")
+			},
+			err: scm.ErrSignatureInvalid,
+		},
+		{
+			name: "query",
+			setup: func(r *http.Request) {
+				r.URL.RawQuery = "secret=71295b197fa25f4356d2fb9965df3f2379d903d7"
+			},
+			err: scm.ErrSignatureInvalid,
+		},
+		{
+			name:  "missing",
+			setup: func(r *http.Request) {},
+			err:   scm.ErrSignatureInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, _ := os.ReadFile("testdata/webhooks/push.json")
+			r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+			test.setup(r)
+
+			s := new(webhookService)
+			_, err := s.Parse(r, secretFunc)
+			if err != test.err {
+				t.Errorf("Expect error %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestWebhookSecretEmpty(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+
+	s := new(webhookService)
+	_, err := s.Parse(r, func(scm.Webhook) (string, error) {
+		return "", nil
+	})
+	if err != nil {
+		t.Errorf("Expect nil error when the secret is empty, got %v", err)
+	}
+}
+
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	f = bytes.Replace(f, []byte(`"git.push"`), []byte(`"git.repo.forked"`), 1)
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverAzure; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "git.repo.forked"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "03c164c2-8912-4d5e-8009-3707d5f83734"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "Fabrikam-Fiber-Git"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookSignatureInvalid(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	f = bytes.Replace(f, []byte(`"git.push"`), []byte(`"git.repo.forked"`), 1)
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Azure-Secret", "a4f7c3e7bd0c9b35b2b1e4a1e2f2d0cb43f3e4a7")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	f = bytes.Replace(f, []byte(`"git.push"`), []byte(`"git.repo.forked"`), 1)
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverAzure,
+		GUID:   "03c164c2-8912-4d5e-8009-3707d5f83734",
+		Event:  "git.push",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
@@ -32,14 +32,17 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverBitbucket
 	client.Linker = &linker{"https://bitbucket.org/"}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -171,6 +174,18 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// diff fetches the raw unified diff and parses the
+// response.
+func (c *wrapper) diff(ctx context.Context, path string) (*scm.Diff, *scm.Response, error) {
+	out := new(bytes.Buffer)
+	res, err := c.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := scm.ParseDiff(out.String())
+	return diff, res, err
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -95,6 +96,35 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, opts
 	return convertContentInfoList(out), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	endpoint := fmt.Sprintf("2.0/repositories/%s/src/%s/%s?annotate=true", repo, url.QueryEscape(ref), path)
+	out := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	ranges := parseAnnotate(out.String())
+
+	// the annotated file only includes the commit sha of
+	// each line, so the details of each commit are fetched
+	// separately.
+	commits := map[string]*scm.Commit{}
+	for _, v := range ranges {
+		found, ok := commits[v.Commit.Sha]
+		if !ok {
+			path := fmt.Sprintf("2.0/repositories/%s/commit/%s", repo, v.Commit.Sha)
+			out := new(commit)
+			if res, err := s.client.do(ctx, "GET", path, nil, out); err != nil {
+				return nil, res, err
+			}
+			found = convertCommit(out)
+			commits[v.Commit.Sha] = found
+		}
+		v.Commit = *found
+	}
+	return ranges, res, nil
+}
+
 type contents struct {
 	pagination
 	Values []*content `json:"values"`
@@ -166,3 +196,31 @@ func convertContentInfo(from *content) *scm.ContentInfo {
 	}
 	return to
 }
+
+// helper function parses the annotated file contents, where
+// each line is prefixed with the sha of the commit that last
+// modified the line, and groups consecutive lines by commit.
+func parseAnnotate(s string) []*scm.BlameRange {
+	to := []*scm.BlameRange{}
+	if s == "" {
+		return to
+	}
+	var last *scm.BlameRange
+	for i, line := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		sha := line
+		if n := strings.IndexAny(line, " \t"); n != -1 {
+			sha = line[:n]
+		}
+		if last != nil && last.Commit.Sha == sha {
+			last.EndLine = i + 1
+			continue
+		}
+		last = &scm.BlameRange{
+			StartLine: i + 1,
+			EndLine:   i + 1,
+			Commit:    scm.Commit{Sha: sha},
+		}
+		to = append(to, last)
+	}
+	return to
+}
@@ -266,3 +266,42 @@ func TestContentListWithUrlInput(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentBlame(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/src/master/main.go").
+		MatchParam("annotate", "true").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/blame.txt")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/c8d8f7e4f3c2b1a0918273645546372819aabbcc").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_initial.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Contents.Blame(context.Background(), "atlassian/stash-example-plugin", "main.go", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.BlameRange{}
+	raw, _ := os.ReadFile("testdata/blame.txt.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
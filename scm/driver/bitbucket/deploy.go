@@ -0,0 +1,158 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo, id string) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deployments/%s", repo, id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deployments/?%s", repo, encodeListOptions(scm.ListOptions{Page: opts.Page, Size: opts.Size}))
+	out := new(deployments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	// bitbucket deployments are created by pipelines and
+	// cannot be created using the api.
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo, id string, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListStatuses(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/environments/?%s", repo, encodeListOptions(opts))
+	out := new(environments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertEnvironmentList(out), res, err
+}
+
+type deployments struct {
+	pagination
+	Values []*deployment `json:"values"`
+}
+
+type deployment struct {
+	UUID  string `json:"uuid"`
+	State struct {
+		Name   string `json:"name"`
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		URL         string    `json:"url"`
+		StartedOn   time.Time `json:"started_on"`
+		CompletedOn time.Time `json:"completed_on"`
+	} `json:"state"`
+	Environment struct {
+		UUID string `json:"uuid"`
+	} `json:"environment"`
+	Release struct {
+		Name   string `json:"name"`
+		URL    string `json:"url"`
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+		CreatedOn time.Time `json:"created_on"`
+	} `json:"release"`
+}
+
+type environments struct {
+	pagination
+	Values []*environment `json:"values"`
+}
+
+type environment struct {
+	UUID            string `json:"uuid"`
+	Name            string `json:"name"`
+	EnvironmentType struct {
+		Name string `json:"name"`
+	} `json:"environment_type"`
+}
+
+func convertDeploymentList(from *deployments) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from.Values {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	to := &scm.Deployment{
+		ID:          from.UUID,
+		Sha:         from.Release.Commit.Hash,
+		Environment: from.Environment.UUID,
+		Desc:        from.Release.Name,
+		State:       convertDeployState(from.State.Name, from.State.Status.Name),
+		Link:        from.State.URL,
+		Created:     from.Release.CreatedOn,
+		Updated:     from.State.CompletedOn,
+	}
+	if to.Updated.IsZero() {
+		to.Updated = from.State.StartedOn
+	}
+	return to
+}
+
+func convertEnvironmentList(from *environments) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from.Values {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *environment) *scm.Environment {
+	return &scm.Environment{
+		ID:   from.UUID,
+		Name: from.Name,
+		Desc: from.EnvironmentType.Name,
+	}
+}
+
+// helper function converts the deployment state. A completed
+// deployment reports the outcome in the nested status name.
+func convertDeployState(state, status string) scm.State {
+	switch state {
+	case "UNDEPLOYED":
+		return scm.StatePending
+	case "IN_PROGRESS":
+		return scm.StateRunning
+	case "COMPLETED":
+		switch status {
+		case "SUCCESSFUL":
+			return scm.StateSuccess
+		case "FAILED":
+			return scm.StateFailure
+		case "STOPPED":
+			return scm.StateCanceled
+		}
+	}
+	return scm.StateUnknown
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deployments/").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	opts := scm.DeploymentListOptions{Page: 1, Size: 10}
+	got, _, err := client.Deployments.List(context.Background(), "atlassian/stash-example-plugin", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := os.ReadFile("testdata/deploys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Deployments.Create(context.Background(), "atlassian/stash-example-plugin", &scm.DeploymentInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentListEnvironments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/environments/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/environments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Deployments.ListEnvironments(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Environment{}
+	raw, _ := os.ReadFile("testdata/environments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
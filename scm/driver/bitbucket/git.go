@@ -27,6 +27,30 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags", repo)
+	in := &createTag{
+		Name:    params.Name,
+		Message: params.Message,
+		Target: target{
+			Hash: params.Sha,
+		},
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTagDetails(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
 	out := new(branch)
@@ -47,9 +71,18 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	path := fmt.Sprintf("2.0/repositories/%s/commit/%s", repo, ref)
 	out := new(commit)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
+	// the commit verification is not populated because
+	// bitbucket cloud does not verify commit signatures,
+	// and neither the commit nor the commit status api
+	// includes the signature.
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/diff/%s", repo, ref)
+	return s.client.diff(ctx, path)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
 	out := new(branch)
@@ -57,6 +90,13 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTagDetails(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches?%s", repo, encodeListOptions(opts))
 	out := new(branches)
@@ -80,6 +120,18 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	// bitbucket does not support filtering commits by date.
+	if !opts.Since.IsZero() || !opts.Until.IsZero() {
+		return nil, nil, scm.ErrNotSupported
+	}
+	endpoint := fmt.Sprintf("2.0/repositories/%s/commits/%s?%s", repo, opts.Ref, encodeFileCommitListOptions(path, opts))
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/tags?%s", repo, encodeListOptions(opts))
 	out := new(branches)
@@ -104,6 +156,77 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertDiffstats(out), res, err
 }
 
+// Compare compares the head commit with the base commit. The
+// ahead and behind counts are calculated by listing the commits
+// that are only reachable from one of the two commits.
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	mergeBase, res, err := s.FindMergeBase(ctx, repo, base, head)
+	if err != nil {
+		return nil, res, err
+	}
+	ahead, res, err := s.listAllCommits(ctx, fmt.Sprintf("2.0/repositories/%s/commits/%s?exclude=%s&pagelen=100", repo, head, base))
+	if err != nil {
+		return nil, res, err
+	}
+	behind, res, err := s.listAllCommits(ctx, fmt.Sprintf("2.0/repositories/%s/commits/%s?exclude=%s&pagelen=100", repo, base, head))
+	if err != nil {
+		return nil, res, err
+	}
+	changes := new(diffstats)
+	path := fmt.Sprintf("2.0/repositories/%s/diffstat/%s..%s?pagelen=100", repo, head, base)
+	for path != "" {
+		out := new(diffstats)
+		res, err = s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		changes.Values = append(changes.Values, out.Values...)
+		path = out.Next
+	}
+	return &scm.Comparison{
+		MergeBase: mergeBase.Sha,
+		Ahead:     len(ahead.Values),
+		Behind:    len(behind.Values),
+		Commits:   convertCommitList(ahead),
+		Changes:   convertDiffstats(changes),
+	}, res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/merge-base/%s..%s", repo, head, base)
+	out := new(commit)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCommit(out), res, err
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/commits/%s?exclude=%s&pagelen=1", repo, ancestor, descendant)
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return len(out.Values) == 0, res, nil
+}
+
+// helper function lists the commits, following the next page
+// links until all commits are returned.
+func (s *gitService) listAllCommits(ctx context.Context, path string) (*commits, *scm.Response, error) {
+	all := new(commits)
+	var res *scm.Response
+	for path != "" {
+		out := new(commits)
+		var err error
+		res, err = s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		all.Values = append(all.Values, out.Values...)
+		path = out.Next
+	}
+	return all, res, nil
+}
+
 type branch struct {
 	Type   string `json:"type"`
 	Name   string `json:"name"`
@@ -117,6 +240,33 @@ type createBranch struct {
 	Target target `json:"target"`
 }
 
+type createTag struct {
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+	Target  target `json:"target"`
+}
+
+type tag struct {
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+	Date    time.Time `json:"date"`
+	Tagger  struct {
+		Raw  string `json:"raw"`
+		User struct {
+			Username    string `json:"username"`
+			DisplayName string `json:"display_name"`
+			Links       struct {
+				Avatar struct {
+					Href string `json:"href"`
+				} `json:"avatar"`
+			} `json:"links"`
+		} `json:"user"`
+	} `json:"tagger"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
 type target struct {
 	Hash string `json:"hash"`
 }
@@ -303,3 +453,22 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.Target.Hash,
 	}
 }
+
+// helper function to convert from the bitbucket tag structure
+// to the common tag structure. Bitbucket does not return the
+// sha of the annotated tag object.
+func convertTagDetails(from *tag) *scm.Tag {
+	return &scm.Tag{
+		Name:    from.Name,
+		Path:    scm.ExpandRef(from.Name, "refs/tags/"),
+		Message: from.Message,
+		Tagger: scm.Signature{
+			Name:   from.Tagger.User.DisplayName,
+			Email:  extractEmail(from.Tagger.Raw),
+			Date:   from.Date,
+			Login:  from.Tagger.User.Username,
+			Avatar: from.Tagger.User.Links.Avatar.Href,
+		},
+		Sha: from.Target.Hash,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -97,6 +98,32 @@ func TestGitCreateBranch(t *testing.T) {
 		t.Errorf("Unexpected Results")
 	}
 }
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/diff/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.FindCommitDiff(context.Background(), "atlassian/stash-example-plugin", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -147,6 +174,96 @@ func TestGitFindTag(t *testing.T) {
 	}
 }
 
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/refs/tags/@atlaskit/activity@1.0.3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.FindTagDetails(context.Background(), "atlassian/atlaskit", "@atlaskit/activity@1.0.3")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_details.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/refs/tags").
+		JSON(map[string]interface{}{
+			"name":    "@atlaskit/activity@1.0.3",
+			"message": "tag for lerna releases\n",
+			"target":  map[string]interface{}{"hash": "ceb01356c3f062579bdfeb15bc53fe151b9e00f0"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	params := &scm.TagInput{
+		Name:    "@atlaskit/activity@1.0.3",
+		Sha:     "ceb01356c3f062579bdfeb15bc53fe151b9e00f0",
+		Message: "tag for lerna releases\n",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.CreateTag(context.Background(), "atlassian/atlaskit", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_details.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/refs/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.DeleteTag(context.Background(), "atlassian/atlaskit", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/refs/branches/feature").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Git.DeleteBranch(context.Background(), "atlassian/atlaskit", "feature")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -177,6 +294,49 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commits/master").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		MatchParam("path", "LICENSE").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{Ref: "master", Page: 1, Size: 30}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Git.ListFileCommits(context.Background(), "atlassian/stash-example-plugin", "LICENSE", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestGitListFileCommits_Since(t *testing.T) {
+	opts := scm.FileCommitListOptions{Since: time.Now()}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.ListFileCommits(context.Background(), "atlassian/stash-example-plugin", "LICENSE", opts)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -320,3 +480,106 @@ func TestGitCompareChanges(t *testing.T) {
 		t.Log(diff)
 	}
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/merge-base/dec26e0fe887167743c2b7e36531dedfeb6cd478..425863f9dbe56d70c8dcdbf2e4e0805e85591fcc").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/commits/dec26e0fe887167743c2b7e36531dedfeb6cd478").
+		MatchParam("exclude", "425863f9dbe56d70c8dcdbf2e4e0805e85591fcc").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits_ahead.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/commits/425863f9dbe56d70c8dcdbf2e4e0805e85591fcc").
+		MatchParam("exclude", "dec26e0fe887167743c2b7e36531dedfeb6cd478").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits_empty.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/diffstat/dec26e0fe887167743c2b7e36531dedfeb6cd478..425863f9dbe56d70c8dcdbf2e4e0805e85591fcc").
+		Reply(200).
+		Type("application/json").
+		File("testdata/diffstat.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.Compare(context.Background(), "atlassian/atlaskit", "425863f9dbe56d70c8dcdbf2e4e0805e85591fcc", "dec26e0fe887167743c2b7e36531dedfeb6cd478")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.MergeBase, "a6e5e7d797edf751cbd839d6bd4aef86c941eec9"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+	if got, want := got.Ahead, 1; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/diffstat.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindMergeBase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/merge-base/feature..master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.FindMergeBase(context.Background(), "atlassian/stash-example-plugin", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := os.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commits/master").
+		MatchParam("exclude", "feature").
+		MatchParam("pagelen", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits_empty.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.IsAncestor(context.Background(), "atlassian/stash-example-plugin", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want master to be an ancestor of feature")
+	}
+}
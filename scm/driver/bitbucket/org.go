@@ -34,6 +34,48 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks?%s", name, encodeListOptions(opts))
+	out := new(hooks)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks", name)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func convertOrganizationList(from *organizationList) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from.Values {
@@ -23,6 +23,11 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/diff", repo, number)
+	return s.client.diff(ctx, path)
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests?%s", repo, encodePullRequestListOptions(opts))
 	out := new(prs)
@@ -53,6 +58,23 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	// bitbucket does not support rebase merges or verifying
+	// the expected head commit of the pull request.
+	if input.Method == scm.MergeMethodRebase || input.Sha != "" {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/merge", repo, number)
+	in := &mergeInput{
+		Type:              "pullrequest",
+		Message:           scm.JoinCommitMessage(input.CommitTitle, input.CommitMessage),
+		CloseSourceBranch: input.DeleteSourceBranch,
+		MergeStrategy:     convertFromMergeMethod(input.Method),
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return res, err
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -102,6 +124,13 @@ func (s *pullService) DeleteComment(ctx context.Context, repo string, number, id
 	return nil, scm.ErrNotSupported
 }
 
+type mergeInput struct {
+	Type              string `json:"type"`
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
 type reference struct {
 	Commit struct {
 		Hash  string `json:"hash"`
@@ -228,3 +257,16 @@ func convertPullRequestComment(from *prComment) *scm.Comment {
 		Updated: from.UpdatedOn,
 	}
 }
+
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodMerge:
+		return "merge_commit"
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodFastForward:
+		return "fast_forward"
+	default:
+		return ""
+	}
+}
@@ -41,6 +41,32 @@ func TestPullFind(t *testing.T) {
 	}
 }
 
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/4982/diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.PullRequests.FindDiff(context.Background(), "atlassian/atlaskit", 4982)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -110,6 +136,43 @@ func TestPullMerge(t *testing.T) {
 	}
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("2.0/repositories/atlassian/atlaskit/pullrequests/1/merge").
+		JSON(map[string]interface{}{
+			"type":                "pullrequest",
+			"message":             "Squashed feature\n\nCloses #1",
+			"close_source_branch": true,
+			"merge_strategy":      "squash",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Squashed feature",
+		CommitMessage:      "Closes #1",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullMergeWithOptions_Sha(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.MergeInput{Sha: "a6e5e7d797edf751cbd839d6bd4aef86c941eec9"}
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	client, _ := New("https://api.bitbucket.org")
 	_, err := client.PullRequests.Close(context.Background(), "atlassian/atlaskit", 1)
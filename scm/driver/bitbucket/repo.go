@@ -6,6 +6,7 @@ package bitbucket
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -65,6 +66,22 @@ type hookInput struct {
 	Events               []string `json:"events"`
 }
 
+type repositoryInput struct {
+	SCM         string `json:"scm,omitempty"`
+	IsPrivate   *bool  `json:"is_private,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Mainbranch  *struct {
+		Name string `json:"name"`
+	} `json:"mainbranch,omitempty"`
+}
+
+type forkInput struct {
+	Workspace *struct {
+		Slug string `json:"slug"`
+	} `json:"workspace,omitempty"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -137,24 +154,11 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 
 // CreateHook creates a new repository webhook.
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("2.0/repositories/%s/hooks", repo)
-	in := new(hookInput)
-	in.URL = target.String()
-	in.SkipCertVerification = input.SkipVerify
-	in.Active = true
-	in.Description = input.Name
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -177,24 +181,11 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 
 // UpdateHook updates a repository webhook.
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("2.0/repositories/%s/hooks/%s", repo, id)
-	in := new(hookInput)
-	in.URL = target.String()
-	in.SkipCertVerification = input.SkipVerify
-	in.Active = true
-	in.Description = input.Name
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PUT", path, in, out)
 	return convertHook(out), res, err
@@ -206,6 +197,97 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries is not supported. Bitbucket only shows
+// the webhook request history in the web interface, when
+// the history is enabled for the webhook, and does not
+// expose the history in the public api.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindHookDelivery is not supported, since the webhook
+// request history is not exposed by the public api.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. Bitbucket only resends a
+// webhook request from the web interface.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// bitbucket cannot initialize the repository contents,
+	// set the main branch on create or create internal
+	// repositories.
+	if input.Readme || input.Gitignore != "" || input.Branch != "" ||
+		input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// bitbucket repositories always belong to a workspace,
+	// and the api does not expose the user's personal
+	// workspace slug.
+	if input.Namespace == "" {
+		return nil, nil, errors.New("bitbucket: a workspace is required to create a repository")
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/%s", input.Namespace, input.Name)
+	in := convertFromRepositoryInput(input)
+	in.SCM = "git"
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Fork forks the repository into the workspace.
+func (s *repositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/forks", repo)
+	in := new(forkInput)
+	if namespace != "" {
+		in.Workspace = &struct {
+			Slug string `json:"slug"`
+		}{Slug: namespace}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates the repository settings.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Visibility == scm.VisibilityInternal || input.Archived {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	in := convertFromRepositoryInput(input)
+	if input.Branch != "" {
+		in.Mainbranch = &struct {
+			Name string `json:"name"`
+		}{Name: input.Branch}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Archive returns ErrNotSupported; bitbucket cloud does
+// not support archiving repositories.
+func (s *repositoryService) Archive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Unarchive returns ErrNotSupported.
+func (s *repositoryService) Unarchive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Delete deletes the repository.
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -234,6 +316,20 @@ func convertRepository(from *repository) *scm.Repository {
 	}
 }
 
+// helper function to convert from the common repository input
+// to the bitbucket repository input structure.
+func convertFromRepositoryInput(from *scm.RepositoryInput) *repositoryInput {
+	to := &repositoryInput{
+		Name:        from.Name,
+		Description: from.Description,
+	}
+	if from.Visibility != scm.VisibilityUndefined {
+		private := from.Visibility == scm.VisibilityPrivate
+		to.IsPrivate = &private
+	}
+	return to
+}
+
 func extractCloneLink(links []cloneLink, names ...string) (href string) {
 	for _, name := range names {
 		for _, link := range links {
@@ -292,6 +388,30 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+// helper function converts the hook input. The secret is
+// appended to the target url because bitbucket does not
+// support signing the hook payload.
+func convertFromHookInput(from *scm.HookInput) (*hookInput, error) {
+	target, err := url.Parse(from.Target)
+	if err != nil {
+		return nil, err
+	}
+	params := target.Query()
+	params.Set("secret", from.Secret)
+	target.RawQuery = params.Encode()
+
+	to := new(hookInput)
+	to.URL = target.String()
+	to.SkipCertVerification = from.SkipVerify
+	to.Active = true
+	to.Description = from.Name
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to, nil
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -351,6 +351,158 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookDeliveries(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Repositories.ListHookDeliveries(context.Background(), "atlassian/stash-example-plugin", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	_, _, err = client.Repositories.FindHookDelivery(context.Background(), "atlassian/stash-example-plugin", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	_, err = client.Repositories.RedeliverHook(context.Background(), "atlassian/stash-example-plugin", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin").
+		JSON(map[string]interface{}{
+			"scm":         "git",
+			"is_private":  true,
+			"name":        "stash-example-plugin",
+			"description": "An example plugin",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:   "atlassian",
+		Name:        "stash-example-plugin",
+		Description: "An example plugin",
+		Visibility:  scm.VisibilityPrivate,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryCreate_NotSupported(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.RepositoryInput{Namespace: "atlassian", Name: "stash-example-plugin", Readme: true}
+	_, _, err := client.Repositories.Create(context.Background(), input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/forks").
+		JSON(map[string]interface{}{
+			"workspace": map[string]interface{}{"slug": "brydzewski"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Fork(context.Background(), "atlassian/stash-example-plugin", "brydzewski")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin").
+		JSON(map[string]interface{}{
+			"is_private":  false,
+			"description": "An example plugin",
+			"mainbranch":  map[string]interface{}{"name": "master"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Description: "An example plugin",
+		Branch:      "master",
+		Visibility:  scm.VisibilityPublic,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Update(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryArchive(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.Archive(context.Background(), "atlassian/stash-example-plugin")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin").
+		Reply(204).Done()
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.Delete(context.Background(), "atlassian/stash-example-plugin")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -6,6 +6,10 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +19,383 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReviewComment(out, nil), res, err
 }
 
+// List returns the inline comments and the replies to the
+// inline comments.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := new(reviewComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertReviewCommentList(out.Values), res, err
 }
 
+// Create creates an inline comment, or replies to the comment
+// identified by InReplyTo. The thread is identified by the
+// first comment id.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := new(reviewCommentInput)
+	in.Content.Raw = input.Body
+	parent := input.InReplyTo
+	if parent == 0 {
+		parent, _ = strconv.Atoi(input.Thread)
+	}
+	if parent != 0 {
+		in.Parent = &commentID{ID: parent}
+	} else {
+		in.Inline = convertFromReviewInput(input)
+	}
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out, nil), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Resolve resolves the comment thread. The thread is identified
+// by the first comment id.
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%s/resolve", repo, number, thread)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// Unresolve reopens the comment thread. The thread is identified
+// by the first comment id.
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%s/resolve", repo, number, thread)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Submit approves the pull request, or requests changes to the
+// pull request. The review body is added as a pull request
+// comment. Bitbucket does not support submitting a batch of
+// review comments.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	if len(input.Comments) != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	var action string
+	switch input.State {
+	case scm.ReviewStateApproved:
+		action = "approve"
+	case scm.ReviewStateChangesRequested:
+		action = "request-changes"
+	case scm.ReviewStateCommented:
+		if input.Body == "" {
+			return nil, nil, scm.ErrNotSupported
+		}
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	to := &scm.ReviewSubmission{
+		Body:  input.Body,
+		State: input.State,
+		Sha:   input.Sha,
+	}
+	var res *scm.Response
+	var err error
+	if input.Body != "" {
+		path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+		in := &prCommentInput{}
+		in.Content.Raw = input.Body
+		out := new(prComment)
+		res, err = s.client.do(ctx, "POST", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		comment := convertPullRequestComment(out)
+		to.ID = comment.ID
+		to.Author = comment.Author
+		to.Submitted = comment.Created
+	}
+	if action != "" {
+		path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/%s", repo, number, action)
+		out := new(participant)
+		res, err = s.client.do(ctx, "POST", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		to.Author = *convertUser(&out.User)
+		to.Submitted = out.ParticipatedOn
+	}
+	return to, res, err
+}
+
+// ListSubmissions returns the pull request participants that
+// approved or requested changes to the pull request.
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertParticipantSubmissionList(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertParticipantReviewerList(out), res, err
+}
+
+// RequestReviewers adds the users to the pull request reviewers.
+// Bitbucket identifies users by uuid or account id.
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, users []string) (*scm.Response, error) {
+	return s.updateReviewers(ctx, repo, number, func(reviewers []*user) []*user {
+		for _, v := range users {
+			if !containsUser(reviewers, v) {
+				reviewers = append(reviewers, convertFromUserID(v))
+			}
+		}
+		return reviewers
+	})
+}
+
+// RemoveReviewers removes the users from the pull request
+// reviewers. Bitbucket identifies users by uuid or account id.
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, users []string) (*scm.Response, error) {
+	return s.updateReviewers(ctx, repo, number, func(reviewers []*user) []*user {
+		var keep []*user
+		for _, v := range reviewers {
+			if !containsUser([]*user{v}, users...) {
+				keep = append(keep, v)
+			}
+		}
+		return keep
+	})
+}
+
+// helper function updates the pull request reviewers. Bitbucket
+// replaces the reviewer list on update, so the current reviewer
+// list is fetched first.
+func (s *reviewService) updateReviewers(ctx context.Context, repo string, number int, fn func([]*user) []*user) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	in := &reviewersInput{Title: out.Title, Reviewers: []*reviewerInput{}}
+	for _, v := range fn(out.Reviewers) {
+		in.Reviewers = append(in.Reviewers, &reviewerInput{
+			UUID:      v.UUID,
+			AccountID: v.AccountID,
+		})
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type reviewComments struct {
+	pagination
+	Values []*reviewComment `json:"values"`
+}
+
+type reviewComment struct {
+	prComment
+	Inline     *inline    `json:"inline"`
+	Parent     *commentID `json:"parent"`
+	Resolution *struct {
+		Type string `json:"type"`
+	} `json:"resolution"`
+}
+
+type reviewCommentInput struct {
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Inline *inline    `json:"inline,omitempty"`
+	Parent *commentID `json:"parent,omitempty"`
+}
+
+type inline struct {
+	Path      string `json:"path"`
+	From      int    `json:"from,omitempty"`
+	To        int    `json:"to,omitempty"`
+	StartFrom int    `json:"start_from,omitempty"`
+	StartTo   int    `json:"start_to,omitempty"`
+}
+
+type commentID struct {
+	ID int `json:"id"`
+}
+
+type prParticipants struct {
+	Title        string         `json:"title"`
+	Reviewers    []*user        `json:"reviewers"`
+	Participants []*participant `json:"participants"`
+}
+
+type participant struct {
+	User           user      `json:"user"`
+	Role           string    `json:"role"`
+	Approved       bool      `json:"approved"`
+	State          string    `json:"state"`
+	ParticipatedOn time.Time `json:"participated_on"`
+}
+
+type reviewersInput struct {
+	Title     string           `json:"title"`
+	Reviewers []*reviewerInput `json:"reviewers"`
+}
+
+type reviewerInput struct {
+	UUID      string `json:"uuid,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// helper function converts the inline comments and the
+// replies to the inline comments. A reply belongs to the
+// thread of the first comment.
+func convertReviewCommentList(from []*reviewComment) []*scm.Review {
+	index := map[int]*reviewComment{}
+	for _, v := range from {
+		index[v.ID] = v
+	}
+	to := []*scm.Review{}
+	for _, v := range from {
+		root := v
+		for root.Parent != nil && index[root.Parent.ID] != nil {
+			root = index[root.Parent.ID]
+		}
+		if v.Inline == nil && root.Inline == nil {
+			continue
+		}
+		to = append(to, convertReviewComment(v, root))
+	}
+	return to
+}
+
+func convertReviewComment(from, root *reviewComment) *scm.Review {
+	comment := convertPullRequestComment(&from.prComment)
+	to := &scm.Review{
+		ID:      comment.ID,
+		Body:    comment.Body,
+		Link:    from.Links.HTML.Href,
+		Author:  comment.Author,
+		Created: comment.Created,
+		Updated: comment.Updated,
+	}
+	if root == nil {
+		root = from
+	}
+	if from.Parent != nil {
+		to.InReplyTo = from.Parent.ID
+		if root == from {
+			to.Thread = strconv.Itoa(from.Parent.ID)
+		}
+	}
+	if to.Thread == "" {
+		to.Thread = strconv.Itoa(root.ID)
+	}
+	to.Resolved = root.Resolution != nil
+	if v := from.Inline; v != nil {
+		to.Path = v.Path
+		to.Line = v.To
+		to.StartLine = v.StartTo
+		if v.To == 0 {
+			to.Line = v.From
+			to.StartLine = v.StartFrom
+			to.Side = scm.DiffSideBase
+		}
+		to.EndLine = to.Line
+	}
+	return to
+}
+
+func convertFromReviewInput(from *scm.ReviewInput) *inline {
+	to := &inline{Path: from.Path}
+	// the line number defaults to the line for compatibility
+	// with callers that do not set the end line.
+	line := from.EndLine
+	if line == 0 {
+		line = from.Line
+	}
+	start := from.StartLine
+	if start == line {
+		start = 0
+	}
+	if from.Side == scm.DiffSideBase {
+		to.From = line
+		to.StartFrom = start
+	} else {
+		to.To = line
+		to.StartTo = start
+	}
+	return to
+}
+
+func convertParticipantSubmissionList(from *prParticipants) []*scm.ReviewSubmission {
+	to := []*scm.ReviewSubmission{}
+	for _, v := range from.Participants {
+		state := convertParticipantState(v)
+		if state == scm.ReviewStatePending {
+			continue
+		}
+		to = append(to, &scm.ReviewSubmission{
+			State:     state,
+			Author:    *convertUser(&v.User),
+			Submitted: v.ParticipatedOn,
+		})
+	}
+	return to
+}
+
+func convertParticipantReviewerList(from *prParticipants) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	for _, v := range from.Participants {
+		state := convertParticipantState(v)
+		if v.Role != "REVIEWER" && state == scm.ReviewStatePending {
+			continue
+		}
+		to = append(to, &scm.Reviewer{
+			User:  *convertUser(&v.User),
+			State: state,
+		})
+	}
+	return to
+}
+
+func convertParticipantState(from *participant) scm.ReviewState {
+	switch {
+	case from.Approved, from.State == "approved":
+		return scm.ReviewStateApproved
+	case from.State == "changes_requested":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStatePending
+	}
+}
+
+// helper function converts the user identifier to a user.
+// A uuid is wrapped in curly braces.
+func convertFromUserID(id string) *user {
+	if strings.HasPrefix(id, "{") {
+		return &user{UUID: id}
+	}
+	return &user{AccountID: id}
+}
+
+// helper function returns true if any of the user identifiers
+// matches the uuid, account id or username of a user.
+func containsUser(users []*user, ids ...string) bool {
+	for _, v := range users {
+		for _, id := range ids {
+			if id != "" && (id == v.UUID || id == v.AccountID || id == v.Username) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -6,35 +6,248 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
-func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.List(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
 	}
-}
 
-func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	want := []*scm.Review{}
+	raw, _ := os.ReadFile("testdata/pr_review_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]interface{}{"raw": "why was this removed?"},
+			"inline":  map[string]interface{}{"path": "README.md", "from": 8, "start_from": 6},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 304, "content": {"raw": "why was this removed?"}, "inline": {"from": 8, "to": null, "start_from": 6, "path": "README.md"}}`)
+
+	input := &scm.ReviewInput{
+		Body:      "why was this removed?",
+		Path:      "README.md",
+		StartLine: 6,
+		Line:      8,
+		Side:      scm.DiffSideBase,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Create(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Side != scm.DiffSideBase || got.StartLine != 6 || got.Line != 8 {
+		t.Errorf("Unexpected review comment position %s %d-%d", got.Side, got.StartLine, got.Line)
+	}
+}
+
+func TestReviewCreateReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]interface{}{"raw": "done"},
+			"parent":  map[string]interface{}{"id": 301},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 302, "content": {"raw": "done"}, "parent": {"id": 301}}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Create(context.Background(), "atlassian/atlaskit", 1, &scm.ReviewInput{Body: "done", Thread: "301"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.InReplyTo != 301 {
+		t.Errorf("Want reply to comment 301, got %d", got.InReplyTo)
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/302").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	if _, err := client.Reviews.Delete(context.Background(), "atlassian/atlaskit", 1, 302); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/301/resolve").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"type": "comment_resolution"}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	if _, err := client.Reviews.Resolve(context.Background(), "atlassian/atlaskit", 1, "301"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/301/resolve").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	if _, err := client.Reviews.Unresolve(context.Background(), "atlassian/atlaskit", 1, "301"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/request-changes").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"user": {"display_name": "Mark Scott"}, "role": "REVIEWER", "approved": false, "state": "changes_requested"}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.ReviewSubmitInput{State: scm.ReviewStateChangesRequested}
+	got, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.State != scm.ReviewStateChangesRequested {
+		t.Errorf("Want changes requested review state, got %s", got.State)
+	}
+	if got.Author.Name != "Mark Scott" {
+		t.Errorf("Want review author Mark Scott, got %s", got.Author.Name)
+	}
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.ListReviewers(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"title": "Updated Files",
+			"reviewers": []map[string]interface{}{
+				{"uuid": "{5a7b0a0e-2a54-4b36-8e0b-4a0a7b6a1c11}", "account_id": "557058:1c7d2a1e-6d0b-4b9f-9e6a-3c2b0a5f1a01"},
+				{"uuid": "{4d8c0f46-cd62-4b77-b0cf-faa3e4d932c6}"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.RequestReviewers(context.Background(), "atlassian/atlaskit", 1, []string{"{4d8c0f46-cd62-4b77-b0cf-faa3e4d932c6}"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers to be updated")
+	}
+}
+
+func TestReviewRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"title":     "Updated Files",
+			"reviewers": []map[string]interface{}{},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.RemoveReviewers(context.Background(), "atlassian/atlaskit", 1, []string{"557058:1c7d2a1e-6d0b-4b9f-9e6a-3c2b0a5f1a01"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers to be updated")
 	}
 }
@@ -0,0 +1,115 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	// code search is scoped to a workspace, which is taken
+	// from the repository name if no namespace is provided.
+	workspace := opts.Namespace
+	if opts.Repo != "" {
+		workspace, _ = scm.Split(opts.Repo)
+	}
+	if workspace == "" {
+		return nil, nil, errors.New("bitbucket: a workspace is required to search code")
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/search/code?%s", workspace, encodeSearchOptions(opts))
+	out := new(codeSearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCodeResultList(out.Values), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.SearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchOptions) ([]*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type codeSearchResults struct {
+	pagination
+	Values []*codeSearchResult `json:"values"`
+}
+
+type codeSearchResult struct {
+	ContentMatches []struct {
+		Lines []struct {
+			Line     int `json:"line"`
+			Segments []struct {
+				Text string `json:"text"`
+			} `json:"segments"`
+		} `json:"lines"`
+	} `json:"content_matches"`
+	File struct {
+		Path  string `json:"path"`
+		Links struct {
+			Self link `json:"self"`
+		} `json:"links"`
+		Commit struct {
+			Hash       string `json:"hash"`
+			Repository struct {
+				FullName string `json:"full_name"`
+				UUID     string `json:"uuid"`
+			} `json:"repository"`
+		} `json:"commit"`
+	} `json:"file"`
+}
+
+func convertCodeResultList(from []*codeSearchResult) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+// helper function converts the code search result. Each
+// matching line is split into highlighted and plain text
+// segments, which are joined to restore the line.
+func convertCodeResult(from *codeSearchResult) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Repo:   from.File.Commit.Repository.FullName,
+		RepoID: from.File.Commit.Repository.UUID,
+		Path:   from.File.Path,
+		Ref:    from.File.Commit.Hash,
+		Link:   from.File.Links.Self.Href,
+	}
+	for _, match := range from.ContentMatches {
+		if len(match.Lines) == 0 {
+			continue
+		}
+		var lines []string
+		for _, line := range match.Lines {
+			var sb strings.Builder
+			for _, segment := range line.Segments {
+				sb.WriteString(segment.Text)
+			}
+			lines = append(lines, sb.String())
+		}
+		to.Fragments = append(to.Fragments, &scm.CodeFragment{
+			Line:    match.Lines[0].Line,
+			Content: strings.Join(lines, "\n"),
+		})
+	}
+	return to
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/search/code").
+		MatchParam("search_query", "github.com/pkg/errors repo:stash-example-plugin").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_code.json")
+
+	opts := scm.SearchOptions{
+		Query: "github.com/pkg/errors",
+		Repo:  "atlassian/stash-example-plugin",
+		Page:  1,
+		Size:  10,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := os.ReadFile("testdata/search_code.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchCode_NoWorkspace(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Search.Code(context.Background(), scm.SearchOptions{Query: "github.com/pkg/errors"})
+	if err == nil {
+		t.Errorf("Expect error when workspace is not provided")
+	}
+}
+
+func TestSearchRepositories(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Search.Repositories(context.Background(), scm.SearchOptions{Query: "stash"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
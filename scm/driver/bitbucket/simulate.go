@@ -0,0 +1,249 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/hmac"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that bitbucket sends, which can be used to test webhook
+// handlers without a connection to bitbucket. If the secret
+// is not empty the payload is signed, and the secret is
+// appended to the target url as the secret query parameter,
+// which is where the webhook parser expects to find it.
+// ErrNotSupported is returned for other hook types and
+// actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, payload = "repo:push", simulatePushHook(v)
+	case *scm.PullRequestHook:
+		var ok bool
+		event, ok = simulatePullRequestEvent(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		payload = simulatePullRequestHook(v)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if secret != "" {
+		uri, err := url.Parse(target)
+		if err != nil {
+			return nil, err
+		}
+		params := uri.Query()
+		params.Set("secret", secret)
+		uri.RawQuery = params.Encode()
+		target = uri.String()
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Bitbucket-Webhooks/2.0")
+	req.Header.Set("X-Event-Key", event)
+	if guid := hook.Meta().GUID; guid != "" {
+		req.Header.Set("X-Request-UUID", guid)
+	}
+	if secret != "" {
+		req.Header.Set("X-Hub-Signature", hmac.SignPrefix(data, []byte(secret)))
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) map[string]interface{} {
+	typ, name := "branch", scm.TrimRef(src.Ref)
+	if scm.IsTag(src.Ref) {
+		typ = "tag"
+	}
+	commits := []interface{}{}
+	for _, c := range src.Commits {
+		commits = append(commits, simulateCommit(&c))
+	}
+	var old interface{}
+	if src.Before != "" {
+		old = map[string]interface{}{
+			"type": typ,
+			"name": name,
+			"target": map[string]interface{}{
+				"type": "commit",
+				"hash": src.Before,
+			},
+		}
+	}
+	head := simulateCommit(&src.Commit)
+	head["hash"] = src.After
+	return map[string]interface{}{
+		"push": map[string]interface{}{
+			"changes": []interface{}{
+				map[string]interface{}{
+					"forced":    false,
+					"created":   old == nil,
+					"closed":    false,
+					"truncated": false,
+					"old":       old,
+					"new": map[string]interface{}{
+						"type":   typ,
+						"name":   name,
+						"target": head,
+					},
+					"commits": commits,
+				},
+			},
+		},
+		"repository": simulateRepository(&src.Repo),
+		"actor":      simulateUser(&src.Sender),
+	}
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook) map[string]interface{} {
+	pull := &src.PullRequest
+	state := "OPEN"
+	switch {
+	case pull.Merged || src.Action == scm.ActionMerge:
+		state = "MERGED"
+	case pull.Closed || src.Action == scm.ActionClose:
+		state = "DECLINED"
+	}
+	fork := pull.Fork
+	if fork == "" {
+		fork = scm.Join(src.Repo.Namespace, src.Repo.Name)
+	}
+	var merge interface{}
+	if pull.Merge != "" {
+		merge = map[string]interface{}{
+			"type": "commit",
+			"hash": pull.Merge,
+		}
+	}
+	return map[string]interface{}{
+		"pullrequest": map[string]interface{}{
+			"type":        "pullrequest",
+			"id":          pull.Number,
+			"title":       pull.Title,
+			"description": pull.Body,
+			"state":       state,
+			"author":      simulateUser(&pull.Author),
+			"created_on":  pull.Created,
+			"updated_on":  pull.Updated,
+			"links": map[string]interface{}{
+				"html": map[string]interface{}{"href": pull.Link},
+				"diff": map[string]interface{}{"href": pull.Diff},
+			},
+			"source": map[string]interface{}{
+				"branch": map[string]interface{}{"name": pull.Source},
+				"commit": map[string]interface{}{"hash": pull.Sha},
+				"repository": map[string]interface{}{
+					"type":      "repository",
+					"full_name": fork,
+				},
+			},
+			"destination": map[string]interface{}{
+				"branch": map[string]interface{}{"name": pull.Target},
+				"commit": map[string]interface{}{"hash": pull.Base.Sha},
+				"repository": map[string]interface{}{
+					"type":      "repository",
+					"full_name": scm.Join(src.Repo.Namespace, src.Repo.Name),
+				},
+			},
+			"merge_commit": merge,
+		},
+		"repository": simulateRepository(&src.Repo),
+		"actor":      simulateUser(&src.Sender),
+	}
+}
+
+func simulateCommit(src *scm.Commit) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "commit",
+		"hash":    src.Sha,
+		"message": src.Message,
+		"date":    src.Author.Date,
+		"author": map[string]interface{}{
+			"type": "author",
+			"raw":  fmt.Sprintf("%s <%s>", src.Author.Name, src.Author.Email),
+			"user": map[string]interface{}{
+				"type":         "user",
+				"username":     src.Author.Login,
+				"display_name": src.Author.Name,
+				"links": map[string]interface{}{
+					"avatar": map[string]interface{}{"href": src.Author.Avatar},
+				},
+			},
+		},
+		"links": map[string]interface{}{
+			"html": map[string]interface{}{"href": src.Link},
+		},
+	}
+}
+
+func simulateRepository(src *scm.Repository) map[string]interface{} {
+	return map[string]interface{}{
+		"type":       "repository",
+		"scm":        "git",
+		"uuid":       src.ID,
+		"name":       src.Name,
+		"full_name":  scm.Join(src.Namespace, src.Name),
+		"is_private": src.Private,
+		"owner": map[string]interface{}{
+			"type":     "user",
+			"username": src.Namespace,
+		},
+		"links": map[string]interface{}{
+			"html": map[string]interface{}{"href": src.Link},
+		},
+	}
+}
+
+func simulateUser(src *scm.User) map[string]interface{} {
+	return map[string]interface{}{
+		"type":         "user",
+		"uuid":         src.ID,
+		"username":     src.Login,
+		"nickname":     src.Login,
+		"display_name": src.Name,
+		"links": map[string]interface{}{
+			"avatar": map[string]interface{}{"href": src.Avatar},
+		},
+	}
+}
+
+// helper function returns the native pull request event.
+func simulatePullRequestEvent(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "pullrequest:created", true
+	case scm.ActionSync, scm.ActionUpdate:
+		return "pullrequest:updated", true
+	case scm.ActionMerge:
+		return "pullrequest:fulfilled", true
+	case scm.ActionClose:
+		return "pullrequest:rejected", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "{2cc80dc0-1c2c-4c0f-b6a1-6c3f5d6d3b1e}",
+	Namespace: "brydzewski",
+	Name:      "hello-world",
+	Clone:     "https://bitbucket.org/brydzewski/hello-world.git",
+	CloneSSH:  "git@bitbucket.org:brydzewski/hello-world.git",
+	Link:      "https://bitbucket.org/brydzewski/hello-world",
+}
+
+var simulateSender = scm.User{
+	ID:     "{fa4ad4d2-6e18-4e79-a8b0-8b8c1b0c3a8f}",
+	Login:  "brydzewski",
+	Name:   "Brad Rydzewski",
+	Avatar: "https://bitbucket.org/account/brydzewski/avatar/32/",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Name:   "Brad Rydzewski",
+		Email:  "brad.rydzewski@gmail.com",
+		Login:  "brydzewski",
+		Avatar: "https://bitbucket.org/account/brydzewski/avatar/32/",
+		Date:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "4522e1bcf8a6ab32f8d7a6bda44cfe0c6f0c6cf7",
+			After:  "ef98532add3b2feb7a137426bba1248724367df5",
+			Commit: scm.Commit{
+				Sha:       "ef98532add3b2feb7a137426bba1248724367df5",
+				Message:   "update readme",
+				Link:      "https://bitbucket.org/brydzewski/hello-world/commits/ef98532add3b2feb7a137426bba1248724367df5",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "ef98532add3b2feb7a137426bba1248724367df5",
+					Message:   "update readme",
+					Link:      "https://bitbucket.org/brydzewski/hello-world/commits/ef98532add3b2feb7a137426bba1248724367df5",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Body:   "adding build instructions to readme",
+				Sha:    "ef98532add3b2feb7a137426bba1248724367df5",
+				Merge:  "f3a2f0a7d9c1b2e4a5d6c7b8a9f0e1d2c3b4a5f6",
+				Ref:    "refs/pull-requests/1/from",
+				Source: "feature",
+				Target: "master",
+				Fork:   "brydzewski/hello-world",
+				Link:   "https://bitbucket.org/brydzewski/hello-world/pull-requests/1",
+				Closed: true,
+				Merged: true,
+				Author: scm.User{
+					Login:  "brydzewski",
+					Name:   "Brad Rydzewski",
+					Avatar: "https://bitbucket.org/account/brydzewski/avatar/32/",
+				},
+				Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Updated: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -38,6 +39,55 @@ func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	u, res, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys?%s", u.UUID, encodeListOptions(opts))
+	out := new(keys)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	u, res, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys", u.UUID)
+	in := &keyInput{
+		Key:   input.Key,
+		Label: input.Title,
+	}
+	out := new(key)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	u, res, err := s.currentUser(ctx)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys/%s", u.UUID, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(context.Context, scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// currentUser returns the authenticated user. The ssh key
+// endpoints are scoped by the account uuid, which is not
+// exposed by the normalized user structure.
+func (s *userService) currentUser(ctx context.Context) (*user, *scm.Response, error) {
+	out := new(user)
+	res, err := s.client.do(ctx, "GET", "2.0/user", nil, out)
+	return out, res, err
+}
+
 func convertEmailList(from *emails) string {
 	for _, v := range from.Values {
 		if v.IsPrimary == true {
@@ -80,3 +130,37 @@ func convertUser(from *user) *scm.User {
 		Name:   from.DisplayName,
 	}
 }
+
+type key struct {
+	UUID      string    `json:"uuid"`
+	Key       string    `json:"key"`
+	Label     string    `json:"label"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+type keyInput struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+func convertKeyList(from *keys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      from.UUID,
+		Title:   from.Label,
+		Key:     from.Key,
+		Created: from.CreatedOn,
+	}
+}
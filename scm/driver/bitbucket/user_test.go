@@ -88,3 +88,92 @@ func TestUserFindEmail(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestUserKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/users/{87bb15eb-47c1-49b3-9f16-ca824a2979a4}/ssh-keys").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.ListKeys(context.Background(), scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Key{}
+	raw, _ := os.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/users/{87bb15eb-47c1-49b3-9f16-ca824a2979a4}/ssh-keys").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title: "laptop",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := os.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/users/{87bb15eb-47c1-49b3-9f16-ca824a2979a4}/ssh-keys/{b15b6026-9c02-4626-b4ad-b905f99f763a}").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Users.DeleteKey(context.Background(), "{b15b6026-9c02-4626-b4ad-b905f99f763a}")
+	if err != nil {
+		t.Error(err)
+	}
+}
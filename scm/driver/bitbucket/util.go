@@ -103,6 +103,18 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -149,3 +161,22 @@ func copyPagination(from pagination, to *scm.Response) error {
 	to.Page.Next, _ = strconv.Atoi(page)
 	return nil
 }
+
+func encodeSearchOptions(opts scm.SearchOptions) string {
+	// the search is narrowed to the repository using
+	// the repo modifier.
+	q := opts.Query
+	if opts.Repo != "" {
+		_, name := scm.Split(opts.Repo)
+		q += " repo:" + name
+	}
+	params := url.Values{}
+	params.Set("search_query", q)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
@@ -32,7 +32,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("x-event-key") {
+	event := req.Header.Get("x-event-key")
+	switch event {
 	case "repo:push":
 		hook, err = s.parsePushHook(data)
 	case "pullrequest:created":
@@ -69,6 +70,16 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		if hook != nil {
 			hook.(*scm.IssueCommentHook).Action = scm.ActionDelete
 		}
+	case "repo:updated":
+		// workspace webhooks deliver repository events for
+		// every repository in the workspace.
+		hook, err = s.parseRepositoryHook(data)
+	case "repo:fork":
+		hook, err = s.parseForkHook(data)
+	default:
+		if s.client != nil && s.client.RawWebhooks {
+			hook, err = s.parseRawHook(req, data)
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -77,6 +88,15 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		return nil, nil
 	}
 
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverBitbucket,
+		GUID:     req.Header.Get("X-Request-UUID"),
+		Event:    event,
+		Received: time.Now(),
+	})
+
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
 	// is performed.
@@ -94,12 +114,49 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	return hook, nil
 }
 
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		namespace, name := scm.Split(src.Repository.FullName)
+		dst.Repo = scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		}
+	}
+	return dst, nil
+}
+
 func (s *webhookService) parsePullRequestCommentHook(data []byte) (scm.Webhook, error) {
 	dst := new(prCommentHook)
 	err := json.Unmarshal(data, dst)
 	return convertPrCommentHook(dst), err
 }
 
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	dst := new(repositoryHook)
+	err := json.Unmarshal(data, dst)
+	return convertRepositoryHook(dst), err
+}
+
+func (s *webhookService) parseForkHook(data []byte) (scm.Webhook, error) {
+	dst := new(forkHook)
+	err := json.Unmarshal(data, dst)
+	return convertForkHook(dst), err
+}
+
 func (s *webhookService) parsePushHook(data []byte) (scm.Webhook, error) {
 	dst := new(pushHook)
 	err := json.Unmarshal(data, dst)
@@ -368,6 +425,34 @@ type (
 		Actor       webhookActor      `json:"actor"`
 	}
 
+	// bitbucket repo:updated webhook payload
+	repositoryHook struct {
+		Repository webhookRepository `json:"repository"`
+		Actor      webhookActor      `json:"actor"`
+		Changes    struct {
+			FullName struct {
+				New string `json:"new"`
+				Old string `json:"old"`
+			} `json:"full_name"`
+			IsPrivate struct {
+				New *bool `json:"new"`
+				Old *bool `json:"old"`
+			} `json:"is_private"`
+		} `json:"changes"`
+	}
+
+	// bitbucket repo:fork webhook payload
+	forkHook struct {
+		Repository webhookRepository `json:"repository"`
+		Fork       webhookRepository `json:"fork"`
+		Actor      webhookActor      `json:"actor"`
+	}
+
+	// bitbucket webhook payload for unrecognized events
+	rawHook struct {
+		Repository *webhookRepository `json:"repository"`
+	}
+
 	webhookRepository struct {
 		Scm   string `json:"scm"`
 		Name  string `json:"name"`
@@ -834,6 +919,62 @@ func convertPullRequestHook(src *webhook) *scm.PullRequestHook {
 	}
 }
 
+//
+// repository hooks
+//
+
+func convertRepositoryHook(src *repositoryHook) *scm.RepositoryHook {
+	dst := &scm.RepositoryHook{
+		Action: scm.ActionUpdate,
+		Repo:   convertWebhookRepository(&src.Repository),
+		Sender: convertWebhookActor(&src.Actor),
+	}
+	// the changes only include the attributes that were
+	// modified, so the previous repository is derived from
+	// the current repository.
+	dst.Previous = dst.Repo
+	if old := src.Changes.FullName.Old; old != "" {
+		dst.Previous.Namespace, dst.Previous.Name = scm.Split(old)
+		dst.Previous.Clone = fmt.Sprintf("https://bitbucket.org/%s.git", old)
+		dst.Previous.CloneSSH = fmt.Sprintf("git@bitbucket.org:%s.git", old)
+		dst.Previous.Link = fmt.Sprintf("https://bitbucket.org/%s", old)
+	}
+	if old := src.Changes.IsPrivate.Old; old != nil {
+		dst.Previous.Private = *old
+	}
+	return dst
+}
+
+func convertForkHook(src *forkHook) *scm.ForkHook {
+	return &scm.ForkHook{
+		Repo:   convertWebhookRepository(&src.Repository),
+		Fork:   convertWebhookRepository(&src.Fork),
+		Sender: convertWebhookActor(&src.Actor),
+	}
+}
+
+func convertWebhookRepository(src *webhookRepository) scm.Repository {
+	namespace, name := scm.Split(src.FullName)
+	return scm.Repository{
+		ID:        src.UUID,
+		Namespace: namespace,
+		Name:      name,
+		Private:   src.IsPrivate,
+		Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.FullName),
+		CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.FullName),
+		Link:      src.Links.HTML.Href,
+	}
+}
+
+func convertWebhookActor(src *webhookActor) scm.User {
+	return scm.User{
+		ID:     src.UUID,
+		Login:  src.Username,
+		Name:   src.DisplayName,
+		Avatar: src.Links.Avatar.Href,
+	}
+}
+
 func convertPrCommentHook(src *prCommentHook) *scm.IssueCommentHook {
 	namespace, _ := scm.Split(src.Repository.FullName)
 	dst := scm.IssueCommentHook{
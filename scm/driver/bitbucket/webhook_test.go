@@ -14,6 +14,7 @@ import (
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -145,6 +146,22 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_comment_deleted.json.golden",
 			obj:    new(scm.IssueCommentHook),
 		},
+		// workspace repository updated
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:updated",
+			before: "testdata/webhooks/repo_updated.json",
+			after:  "testdata/webhooks/repo_updated.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// workspace repository forked
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:fork",
+			before: "testdata/webhooks/repo_fork.json",
+			after:  "testdata/webhooks/repo_fork.json.golden",
+			obj:    new(scm.ForkHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -176,7 +193,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 
@@ -225,6 +242,85 @@ func TestWebhookValidated(t *testing.T) {
 	}
 }
 
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:commit_comment_created")
+	r.Header.Set("X-Request-UUID", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverBitbucket; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "repo:commit_comment_created"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "foo"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:commit_comment_created")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	o, err := s.Parse(r, secretFunc)
+	if o != nil || err != nil {
+		t.Errorf("Expect nil hook and nil error when raw webhooks are disabled, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:push")
+	r.Header.Set("X-Request-UUID", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverBitbucket,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "repo:push",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
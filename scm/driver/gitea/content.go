@@ -45,6 +45,11 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 	return convertContentInfoList(out), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	// gitea does not expose blame in its rest api.
+	return nil, nil, scm.ErrNotSupported
+}
+
 type content struct {
 	Path string `json:"path"`
 	Type string `json:"type"`
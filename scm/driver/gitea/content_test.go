@@ -43,6 +43,14 @@ func TestContentFind(t *testing.T) {
 	}
 }
 
+func TestContentBlame(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Contents.Blame(context.Background(), "go-gitea/gitea", "README.md", "main")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestContentCreate(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", nil)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -21,6 +22,28 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return nil, scm.ErrNotSupported
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags", repo)
+	in := &tagInput{
+		TagName: params.Name,
+		Target:  params.Sha,
+		Message: params.Message,
+	}
+	out := new(releaseTag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReleaseTag(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -36,6 +59,12 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommitInfo(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	ref = scm.TrimRef(ref)
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s.diff", repo, url.PathEscape(ref))
+	return s.client.diff(ctx, path)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	name = scm.TrimRef(name)
 	path := fmt.Sprintf("api/v1/repos/%s/git/refs/tags/%s", repo, url.PathEscape(name))
@@ -52,6 +81,33 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return nil, res, scm.ErrNotFound
 }
 
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, url.PathEscape(scm.TrimRef(name)))
+	out := new(releaseTag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertReleaseTag(out)
+	if to.Object == "" {
+		return to, res, nil
+	}
+	// the tagger is only available from the annotated
+	// tag object.
+	path = fmt.Sprintf("api/v1/repos/%s/git/tags/%s", repo, to.Object)
+	obj := new(annotatedTag)
+	res, err = s.client.do(ctx, "GET", path, nil, obj)
+	if err != nil {
+		return nil, res, err
+	}
+	to.Tagger = scm.Signature{
+		Name:  obj.Tagger.Name,
+		Email: obj.Tagger.Email,
+		Date:  obj.Tagger.Date,
+	}
+	return to, res, nil
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches?%s", repo, encodeListOptions(opts))
 	out := []*branch{}
@@ -72,6 +128,13 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, _ scm.CommitL
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v1/repos/%s/commits?%s", repo, encodeFileCommitListOptions(path, opts))
+	out := []*commitInfo{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/git/refs/tags", repo)
 	out := []*tag{}
@@ -87,6 +150,45 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return nil, nil, scm.ErrNotSupported
 }
 
+// Compare compares the head commit with the base commit. The
+// compare api does not return the behind count or the merge
+// base, so the commits are also compared in the reverse
+// direction, and the merge base is not set.
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, base, head)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, head, base)
+	reverse := new(compare)
+	res, err = s.client.do(ctx, "GET", path, nil, reverse)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Comparison{
+		Ahead:   out.TotalCommits,
+		Behind:  reverse.TotalCommits,
+		Commits: convertCommitList(out.Commits),
+		Changes: convertCompareChanges(out.Commits),
+	}, res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, descendant, ancestor)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return out.TotalCommits == 0, res, nil
+}
+
 //
 // native data structures
 //
@@ -107,6 +209,16 @@ type (
 		Author    signature `json:"author"`
 		Committer signature `json:"committer"`
 		Timestamp time.Time `json:"timestamp"`
+
+		Verification *verification `json:"verification"`
+	}
+
+	// gitea commit verification object.
+	verification struct {
+		Verified  bool      `json:"verified"`
+		Reason    string    `json:"reason"`
+		Signature string    `json:"signature"`
+		Signer    signature `json:"signer"`
 	}
 
 	// gitea commit info object.
@@ -115,6 +227,16 @@ type (
 		Commit    commit `json:"commit"`
 		Author    user   `json:"author"`
 		Committer user   `json:"committer"`
+		Files     []struct {
+			Filename string `json:"filename"`
+			Status   string `json:"status"`
+		} `json:"files"`
+	}
+
+	// gitea compare object.
+	compare struct {
+		TotalCommits int           `json:"total_commits"`
+		Commits      []*commitInfo `json:"commits"`
 	}
 
 	// gitea signature object.
@@ -134,6 +256,35 @@ type (
 			URL  string `json:"url"`
 		} `json:"object"`
 	}
+
+	// gitea tag creation request.
+	tagInput struct {
+		TagName string `json:"tag_name"`
+		Target  string `json:"target"`
+		Message string `json:"message,omitempty"`
+	}
+
+	// gitea repository tag object.
+	releaseTag struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+		ID      string `json:"id"`
+		Commit  struct {
+			Sha string `json:"sha"`
+		} `json:"commit"`
+	}
+
+	// gitea annotated tag object.
+	annotatedTag struct {
+		Tag     string `json:"tag"`
+		Sha     string `json:"sha"`
+		Message string `json:"message"`
+		Tagger  struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"tagger"`
+	}
 )
 
 //
@@ -171,7 +322,30 @@ func convertCommitInfo(src *commitInfo) *scm.Commit {
 		Message:   src.Commit.Message,
 		Author:    convertUserSignature(src.Author),
 		Committer: convertUserSignature(src.Committer),
+
+		Verification: convertVerification(src.Commit.Verification),
+	}
+}
+
+// helper function converts the commit verification. The
+// signature type is derived from the armored signature
+// header because gitea does not include it in the response.
+func convertVerification(src *verification) *scm.Verification {
+	if src == nil {
+		return nil
+	}
+	dst := &scm.Verification{
+		Verified: src.Verified,
+		Reason:   src.Reason,
+		Signer:   src.Signer.Username,
 	}
+	switch {
+	case strings.HasPrefix(src.Signature, "-----BEGIN PGP SIGNATURE-----"):
+		dst.Type = "gpg"
+	case strings.HasPrefix(src.Signature, "-----BEGIN SSH SIGNATURE-----"):
+		dst.Type = "ssh"
+	}
+	return dst
 }
 
 func convertSignature(src signature) scm.Signature {
@@ -206,3 +380,53 @@ func convertTag(src *tag) *scm.Reference {
 		Sha:  src.Object.Sha,
 	}
 }
+
+// helper function to convert from the gitea repository tag to
+// the common tag structure. The tag id is the sha of the tag
+// object for annotated tags, and the commit sha otherwise, in
+// which case gitea returns the commit message.
+func convertReleaseTag(src *releaseTag) *scm.Tag {
+	dst := &scm.Tag{
+		Name: src.Name,
+		Path: scm.ExpandRef(src.Name, "refs/tags"),
+		Sha:  src.Commit.Sha,
+	}
+	if src.ID != "" && src.ID != src.Commit.Sha {
+		dst.Object = src.ID
+		dst.Message = src.Message
+	}
+	return dst
+}
+
+// helper function returns the files changed by the compared
+// commits. The compare api does not return the changeset, so
+// it is aggregated from the files affected by each commit.
+func convertCompareChanges(src []*commitInfo) []*scm.Change {
+	var changes []*scm.Change
+	index := map[string]*scm.Change{}
+	for _, v := range src {
+		for _, file := range v.Files {
+			change, ok := index[file.Filename]
+			if !ok {
+				change = &scm.Change{Path: file.Filename}
+				index[file.Filename] = change
+				changes = append(changes, change)
+			}
+			switch file.Status {
+			case "added":
+				change.Added = true
+			case "removed":
+				change.Deleted = true
+			}
+		}
+	}
+	// files that are both added and removed by the compared
+	// commits are not part of the changeset.
+	dst := []*scm.Change{}
+	for _, change := range changes {
+		if !change.Added || !change.Deleted {
+			dst = append(dst, change)
+		}
+	}
+	return dst
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
@@ -46,6 +47,32 @@ func TestGitFindCommit(t *testing.T) {
 	}
 }
 
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/gitea/gitea/git/commits/c43399cad8766ee521b873a32c1652407c5a4630.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.FindCommitDiff(context.Background(), "gitea/gitea", "c43399cad8766ee521b873a32c1652407c5a4630")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	gock.New("https://try.gitea.io").
 		Get("/api/v1/repos/go-gitea/gitea/commits").
@@ -69,6 +96,40 @@ func TestGitListCommits(t *testing.T) {
 	}
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/commits").
+		MatchParam("path", "README.md").
+		MatchParam("sha", "main").
+		MatchParam("since", "2020-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{
+		Ref:   "main",
+		Since: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.ListFileCommits(context.Background(), "go-gitea/gitea", "README.md", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListChanges(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
@@ -90,6 +151,75 @@ func TestGitCompareChanges(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/master...feature").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/feature...master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare_reverse.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.Compare(context.Background(), "go-gitea/gitea", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.Ahead, 2; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+	if got, want := len(got.Commits), 2; got != want {
+		t.Errorf("Want %d commits, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/compare.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/feature...master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare_reverse.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.IsAncestor(context.Background(), "go-gitea/gitea", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want master to be an ancestor of feature")
+	}
+}
+
+func TestGitFindMergeBase(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.FindMergeBase(context.Background(), "go-gitea/gitea", "master", "feature")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
 
 //
 // branch sub-tests
@@ -175,6 +305,98 @@ func TestGitFindTag(t *testing.T) {
 	}
 }
 
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/tags/v1.2.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release_tag.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/tags/5c8a3d0b7a2e4f1d9c6b8a7e5d4c3b2a1f0e9d8c").
+		Reply(200).
+		Type("application/json").
+		File("testdata/annotated_tag.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.FindTagDetails(context.Background(), "go-gitea/gitea", "v1.2.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/release_tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/tags").
+		JSON(map[string]interface{}{
+			"tag_name": "v1.2.0",
+			"target":   "7dcd2a6c8db0ee7a21d4bf1fd9c4e5c7b2e1d1b8",
+			"message":  "Release v1.2.0\n",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/release_tag.json")
+
+	params := &scm.TagInput{
+		Name:    "v1.2.0",
+		Sha:     "7dcd2a6c8db0ee7a21d4bf1fd9c4e5c7b2e1d1b8",
+		Message: "Release v1.2.0\n",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.Object, "5c8a3d0b7a2e4f1d9c6b8a7e5d4c3b2a1f0e9d8c"; got != want {
+		t.Errorf("Want tag object %s, got %s", want, got)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/tags/v1.2.0").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.DeleteTag(context.Background(), "go-gitea/gitea", "v1.2.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/branches/feature").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "go-gitea/gitea", "feature")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGitListTags(t *testing.T) {
 	defer gock.Off()
 
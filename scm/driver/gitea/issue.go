@@ -44,11 +44,42 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: input.Assignees,
+		Milestone: input.Milestone,
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// gitea only accepts label ids when creating an issue,
+	// so labels are added by name once the issue exists.
+	if len(input.Labels) != 0 {
+		res, err = s.AddLabels(ctx, repo, out.Number, input.Labels)
+	}
+	return convertIssue(out), res, err
+}
+
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	if len(input.Labels) != 0 {
+		path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+		in := &issueLabelsInput{Labels: input.Labels}
+		res, err := s.client.do(ctx, "PUT", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueInput{
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: input.Assignees,
+		Milestone: input.Milestone,
+	}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
 	return convertIssue(out), res, err
 }
 
@@ -68,7 +99,51 @@ func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "closed"}
+	return s.client.do(ctx, "PATCH", path, &data, nil)
+}
+
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	return s.client.do(ctx, "PATCH", path, &data, nil)
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	in := &issueLabelsInput{Labels: labels}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	// gitea removes issue labels by id, so we look up the
+	// label id from the issue labels.
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out {
+		if v.Name == name {
+			path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels/%d", repo, number, v.ID)
+			return s.client.do(ctx, "DELETE", path, nil, nil)
+		}
+	}
+	return res, scm.ErrNotFound
+}
+
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueAssigneesInput{Assignees: append([]string{}, logins...)}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueMilestoneInput{Milestone: milestone}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -104,8 +179,33 @@ type (
 
 	// gitea issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string   `json:"title,omitempty"`
+		Body      string   `json:"body,omitempty"`
+		Assignees []string `json:"assignees,omitempty"`
+		Milestone int      `json:"milestone,omitempty"`
+	}
+
+	// gitea issue labels request object.
+	issueLabelsInput struct {
+		Labels []string `json:"labels"`
+	}
+
+	// gitea issue assignees request object.
+	issueAssigneesInput struct {
+		Assignees []string `json:"assignees"`
+	}
+
+	// gitea issue milestone request object.
+	issueMilestoneInput struct {
+		Milestone int `json:"milestone"`
+	}
+
+	// gitea label response object.
+	label struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Color       string `json:"color"`
+		Description string `json:"description"`
 	}
 
 	// gitea issue comment response object.
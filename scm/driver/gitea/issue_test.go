@@ -99,11 +99,172 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []string{"bug"}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}]`)
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"assignees": []string{"gogs"},
+			"milestone": 2,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Labels:    []string{"bug"},
+		Assignees: []string{"gogs"},
+		Milestone: 2,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.Update(context.Background(), "go-gitea/gitea", 1, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := os.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueAddLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []string{"bug", "ui"}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 4, "name": "ui", "color": "c5def5"}]`)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.AddLabels(context.Background(), "go-gitea/gitea", 1, []string{"bug", "ui"})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 4, "name": "ui", "color": "c5def5"}]`)
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/1/labels/4").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.RemoveLabel(context.Background(), "go-gitea/gitea", 1, "ui")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect issue label to be removed")
+	}
+}
+
+func TestIssueRemoveLabel_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[]`)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.RemoveLabel(context.Background(), "go-gitea/gitea", 1, "ui")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]interface{}{"assignees": []string{"gogs", "jcitizen"}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.SetAssignees(context.Background(), "go-gitea/gitea", 1, []string{"gogs", "jcitizen"})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueSetMilestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]interface{}{"milestone": 0}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.SetMilestone(context.Background(), "go-gitea/gitea", 1, 0)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
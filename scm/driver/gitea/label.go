@@ -0,0 +1,146 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels?%s", repo, encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertFromLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	existing, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, existing.ID)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertFromLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	existing, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, existing.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddToIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabels(ctx, repo, number, []string{name})
+}
+
+func (s *labelService) RemoveFromIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, name)
+}
+
+// AddToPullRequest adds the label to the pull request. Gitea
+// manages pull request labels through the issues api.
+func (s *labelService) AddToPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.AddToIssue(ctx, repo, number, name)
+}
+
+// RemoveFromPullRequest removes the label from the pull request.
+// Gitea manages pull request labels through the issues api.
+func (s *labelService) RemoveFromPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.RemoveFromIssue(ctx, repo, number, name)
+}
+
+// helper function returns the named repository label. Gitea
+// identifies labels by id, so we page through the repository
+// labels to find the label by name.
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 50}
+	for {
+		path := fmt.Sprintf("api/v1/repos/%s/labels?%s", repo, encodeListOptions(opts))
+		out := []*label{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			if v.Name == name {
+				return v, res, nil
+			}
+		}
+		if len(out) < opts.Size {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page++
+	}
+}
+
+type labelInput struct {
+	Name        string `json:"name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Color:       strings.TrimPrefix(from.Color, "#"),
+		Description: from.Description,
+	}
+}
+
+// helper function converts the hex color code to the gitea
+// color format, which includes a leading hash.
+func convertFromLabelColor(from string) string {
+	if from == "" {
+		return from
+	}
+	return "#" + strings.TrimPrefix(from, "#")
+}
@@ -0,0 +1,190 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		MatchParam("page", "1").
+		MatchParam("limit", "50").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Labels.Find(context.Background(), "go-gitea/gitea", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := os.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Labels.Find(context.Background(), "go-gitea/gitea", "security")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Labels.List(context.Background(), "go-gitea/gitea", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := os.ReadFile("testdata/labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/labels").
+		JSON(map[string]string{
+			"name":        "bug",
+			"color":       "#ee0701",
+			"description": "Something is not working",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:        "bug",
+		Color:       "ee0701",
+		Description: "Something is not working",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Labels.Create(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := os.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/labels/4").
+		JSON(map[string]string{"name": "frontend"}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 4, "name": "frontend", "color": "c5def5", "description": ""}`)
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Labels.Update(context.Background(), "go-gitea/gitea", "ui", &scm.LabelInput{Name: "frontend"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "frontend" {
+		t.Errorf("Want label name frontend, got %s", got.Name)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/labels/1").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Labels.Delete(context.Background(), "go-gitea/gitea", "bug")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect label to be deleted")
+	}
+}
+
+func TestLabelAddToPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []string{"bug"}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}]`)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Labels.AddToPullRequest(context.Background(), "go-gitea/gitea", 1, "bug")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -23,6 +23,11 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, index int) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d.diff", repo, index)
+	return s.client.diff(ctx, path)
+}
+
 func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -73,6 +78,19 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.R
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, index int, input *scm.MergeInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, index)
+	in := &mergeInput{
+		Do:                     convertFromMergeMethod(input.Method),
+		MergeTitleField:        input.CommitTitle,
+		MergeMessageField:      input.CommitMessage,
+		HeadCommitID:           input.Sha,
+		DeleteBranchAfterMerge: input.DeleteSourceBranch,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return res, err
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -135,6 +153,14 @@ type reference struct {
 	Sha  string     `json:"sha"`
 }
 
+type mergeInput struct {
+	Do                     string `json:"Do"`
+	MergeTitleField        string `json:"MergeTitleField,omitempty"`
+	MergeMessageField      string `json:"MergeMessageField,omitempty"`
+	HeadCommitID           string `json:"head_commit_id,omitempty"`
+	DeleteBranchAfterMerge bool   `json:"delete_branch_after_merge,omitempty"`
+}
+
 type prInput struct {
 	Title string `json:"title"`
 	Body  string `json:"body"`
@@ -194,3 +220,16 @@ func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 		Updated: src.Updated,
 	}
 }
+
+func convertFromMergeMethod(src scm.MergeMethod) string {
+	switch src {
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	case scm.MergeMethodFastForward:
+		return "fast-forward-only"
+	default:
+		return "merge"
+	}
+}
@@ -69,6 +69,32 @@ func TestPullRequestList(t *testing.T) {
 	}
 }
 
+func TestPullRequestFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.FindDiff(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullRequestCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -124,6 +150,32 @@ func TestPullRequestMerge(t *testing.T) {
 	}
 }
 
+func TestPullRequestMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		JSON(map[string]interface{}{
+			"Do":                        "rebase",
+			"head_commit_id":            "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"delete_branch_after_merge": true,
+		}).
+		Reply(204).
+		Type("application/json")
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodRebase,
+		Sha:                "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 //
 // pull request change sub-tests
 //
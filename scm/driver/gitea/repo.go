@@ -66,25 +66,11 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo string, ref str
 }
 
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("api/v1/repos/%s/hooks", repo)
-	in := new(hook)
-	in.Type = "gitea"
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = target.String()
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvent(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -104,25 +90,11 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref s
 }
 
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
-	in := new(hook)
-	in.Type = "gitea"
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = target.String()
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvent(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
 	return convertHook(out), res, err
@@ -133,6 +105,100 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries is not supported. Gitea records the
+// hook tasks, however the tasks are only displayed in the
+// web interface and are not exposed by the api.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindHookDelivery is not supported, since the hook tasks
+// are not exposed by the api.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. Gitea only replays a hook
+// task from the web interface, and the hook test endpoint
+// sends a new push event instead of the original delivery.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "api/v1/user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("api/v1/orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Private:       input.Visibility == scm.VisibilityPrivate,
+		DefaultBranch: input.Branch,
+		AutoInit:      input.Readme || input.Gitignore != "",
+		Gitignores:    input.Gitignore,
+	}
+	if input.Readme {
+		in.Readme = "Default"
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/forks", repo)
+	in := &forkInput{Organization: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	in := &repositoryEditInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		private := input.Visibility == scm.VisibilityPrivate
+		in.Private = &private
+	}
+	if input.Archived {
+		archived := true
+		in.Archived = &archived
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	return s.archive(ctx, repo, true)
+}
+
+func (s *repositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	return s.archive(ctx, repo, false)
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) archive(ctx context.Context, repo string, archived bool) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	in := &repositoryEditInput{Archived: &archived}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 //
 // native data structures
 //
@@ -156,6 +222,31 @@ type (
 		Archived      bool      `json:"archived"`
 	}
 
+	// gitea repository creation request.
+	repositoryInput struct {
+		Name          string `json:"name"`
+		Description   string `json:"description,omitempty"`
+		Private       bool   `json:"private,omitempty"`
+		AutoInit      bool   `json:"auto_init,omitempty"`
+		Gitignores    string `json:"gitignores,omitempty"`
+		Readme        string `json:"readme,omitempty"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+	}
+
+	// gitea repository edit request.
+	repositoryEditInput struct {
+		Name          string `json:"name,omitempty"`
+		Description   string `json:"description,omitempty"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+		Private       *bool  `json:"private,omitempty"`
+		Archived      *bool  `json:"archived,omitempty"`
+	}
+
+	// gitea repository fork request.
+	forkInput struct {
+		Organization string `json:"organization,omitempty"`
+	}
+
 	// gitea permissions details.
 	perm struct {
 		Admin bool `json:"admin"`
@@ -250,6 +341,28 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertFromHookInput(from *scm.HookInput) (*hook, error) {
+	target, err := url.Parse(from.Target)
+	if err != nil {
+		return nil, err
+	}
+	params := target.Query()
+	params.Set("secret", from.Secret)
+	target.RawQuery = params.Encode()
+
+	to := new(hook)
+	to.Type = "gitea"
+	to.Active = true
+	to.Config.Secret = from.Secret
+	to.Config.ContentType = "json"
+	to.Config.URL = target.String()
+	to.Events = append(
+		from.NativeEvents,
+		convertHookEvent(from.Events)...,
+	)
+	return to, nil
+}
+
 func convertHookEvent(from scm.HookEvents) []string {
 	var events []string
 	if from.PullRequest {
@@ -230,6 +230,199 @@ func TestHookDelete(t *testing.T) {
 	}
 }
 
+func TestHookDeliveries(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Repositories.ListHookDeliveries(context.Background(), "go-gitea/gitea", "20", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	_, _, err = client.Repositories.FindHookDelivery(context.Background(), "go-gitea/gitea", "20", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	_, err = client.Repositories.RedeliverHook(context.Background(), "go-gitea/gitea", "20", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepoCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/go-gitea/repos").
+		JSON(map[string]interface{}{
+			"name":           "gitea",
+			"description":    "Git with a cup of tea",
+			"private":        true,
+			"auto_init":      true,
+			"gitignores":     "Go",
+			"readme":         "Default",
+			"default_branch": "master",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:   "go-gitea",
+		Name:        "gitea",
+		Description: "Git with a cup of tea",
+		Branch:      "master",
+		Visibility:  scm.VisibilityPrivate,
+		Readme:      true,
+		Gitignore:   "Go",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/forks").
+		JSON(map[string]interface{}{"organization": "gogits"}).
+		Reply(202).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Fork(context.Background(), "go-gitea/gitea", "gogits")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		JSON(map[string]interface{}{
+			"description":    "Git with a cup of tea",
+			"default_branch": "master",
+			"private":        false,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Description: "Git with a cup of tea",
+		Branch:      "master",
+		Visibility:  scm.VisibilityPublic,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Update(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdateArchived(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		JSON(map[string]interface{}{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{Archived: true}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Repositories.Update(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		JSON(map[string]interface{}{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Archive(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoUnarchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		JSON(map[string]interface{}{"archived": false}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Unarchive(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Delete(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
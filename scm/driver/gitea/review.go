@@ -6,6 +6,8 @@ package gitea
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -29,3 +31,181 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := &reviewSubmitInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Event:    convertFromReviewState(input.State),
+	}
+	for _, v := range input.Comments {
+		comment := &reviewSubmitComment{
+			Body: v.Body,
+			Path: v.Path,
+		}
+		line := v.EndLine
+		if line == 0 {
+			line = v.Line
+		}
+		if v.Side == scm.DiffSideBase {
+			comment.OldPosition = line
+		} else {
+			comment.NewPosition = line
+		}
+		in.Comments = append(in.Comments, comment)
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewSubmission(out), res, err
+}
+
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*review{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewSubmissionList(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &reviewDismissInput{Message: message}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+// ListReviewers returns the reviewers derived from the review
+// list. Gitea records review requests as reviews, so the latest
+// review of each reviewer determines the reviewer state.
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	submissions, res, err := s.ListSubmissions(ctx, repo, number, opts)
+	return convertReviewerList(submissions), res, err
+}
+
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{Reviewers: logins}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{Reviewers: logins}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+//
+// native data structures
+//
+
+type (
+	review struct {
+		ID          int       `json:"id"`
+		Reviewer    user      `json:"user"`
+		State       string    `json:"state"`
+		Body        string    `json:"body"`
+		CommitID    string    `json:"commit_id"`
+		Dismissed   bool      `json:"dismissed"`
+		HTMLURL     string    `json:"html_url"`
+		SubmittedAt time.Time `json:"submitted_at"`
+	}
+
+	reviewSubmitInput struct {
+		Body     string                 `json:"body,omitempty"`
+		CommitID string                 `json:"commit_id,omitempty"`
+		Event    string                 `json:"event"`
+		Comments []*reviewSubmitComment `json:"comments,omitempty"`
+	}
+
+	reviewSubmitComment struct {
+		Body        string `json:"body"`
+		Path        string `json:"path"`
+		NewPosition int    `json:"new_position,omitempty"`
+		OldPosition int    `json:"old_position,omitempty"`
+	}
+
+	reviewDismissInput struct {
+		Message string `json:"message"`
+	}
+
+	reviewersInput struct {
+		Reviewers []string `json:"reviewers"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertReviewSubmissionList(src []*review) []*scm.ReviewSubmission {
+	dst := []*scm.ReviewSubmission{}
+	for _, v := range src {
+		dst = append(dst, convertReviewSubmission(v))
+	}
+	return dst
+}
+
+func convertReviewSubmission(src *review) *scm.ReviewSubmission {
+	dst := &scm.ReviewSubmission{
+		ID:        src.ID,
+		Body:      src.Body,
+		State:     convertReviewState(src.State),
+		Sha:       src.CommitID,
+		Link:      src.HTMLURL,
+		Author:    *convertUser(&src.Reviewer),
+		Submitted: src.SubmittedAt,
+	}
+	if src.Dismissed {
+		dst.State = scm.ReviewStateDismissed
+	}
+	return dst
+}
+
+func convertReviewerList(src []*scm.ReviewSubmission) []*scm.Reviewer {
+	dst := []*scm.Reviewer{}
+	index := map[string]*scm.Reviewer{}
+	for _, v := range src {
+		reviewer, ok := index[v.Author.Login]
+		if !ok {
+			reviewer = &scm.Reviewer{User: v.Author, State: v.State}
+			index[v.Author.Login] = reviewer
+			dst = append(dst, reviewer)
+		} else if v.State != scm.ReviewStateCommented {
+			reviewer.State = v.State
+		}
+	}
+	return dst
+}
+
+func convertReviewState(src string) scm.ReviewState {
+	switch src {
+	case "PENDING", "REQUEST_REVIEW":
+		return scm.ReviewStatePending
+	case "COMMENT":
+		return scm.ReviewStateCommented
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "REQUEST_CHANGES":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+func convertFromReviewState(src scm.ReviewState) string {
+	switch src {
+	case scm.ReviewStateApproved:
+		return "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	default:
+		return "COMMENT"
+	}
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
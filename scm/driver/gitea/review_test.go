@@ -6,9 +6,13 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -42,3 +46,129 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		JSON(map[string]interface{}{
+			"body":  "lgtm",
+			"event": "APPROVED",
+			"comments": []map[string]interface{}{
+				{"body": "nit", "path": "README.md", "new_position": 2},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	input := &scm.ReviewSubmitInput{
+		Body:  "lgtm",
+		State: scm.ReviewStateApproved,
+		Comments: []*scm.ReviewInput{
+			{Body: "nit", Path: "README.md", Line: 2},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.Submit(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewSubmission)
+	raw, _ := os.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewListSubmissions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.ListSubmissions(context.Background(), "jcitizen/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSubmission{}
+	raw, _ := os.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.ListReviewers(context.Background(), "jcitizen/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/6/dismissals").
+		JSON(map[string]interface{}{"message": "stale"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Reviews.Dismiss(context.Background(), "jcitizen/my-repo", 1, 6, "stale")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/requested_reviewers").
+		JSON(map[string]interface{}{"reviewers": []string{"octocat"}}).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Reviews.RequestReviewers(context.Background(), "jcitizen/my-repo", 1, []string{"octocat"})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -7,6 +7,8 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -37,10 +39,68 @@ func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/keys/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
 //
 // native data structures
 //
 
+type (
+	// gitea ssh key object.
+	key struct {
+		ID      int       `json:"id"`
+		Title   string    `json:"title"`
+		Key     string    `json:"key"`
+		Created time.Time `json:"created_at"`
+	}
+
+	// gitea ssh key creation request.
+	keyInput struct {
+		Title string `json:"title"`
+		Key   string `json:"key"`
+	}
+
+	// gitea gpg key object.
+	gpgKey struct {
+		ID        int    `json:"id"`
+		KeyID     string `json:"key_id"`
+		PublicKey string `json:"public_key"`
+		Emails    []struct {
+			Email    string `json:"email"`
+			Verified bool   `json:"verified"`
+		} `json:"emails"`
+		Created time.Time `json:"created_at"`
+		Expires time.Time `json:"expires_at"`
+	}
+)
+
 type user struct {
 	ID       int    `json:"id"`
 	Login    string `json:"login"`
@@ -69,3 +129,42 @@ func userLogin(src *user) string {
 	}
 	return src.Login
 }
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:      strconv.Itoa(src.ID),
+		Title:   src.Title,
+		Key:     src.Key,
+		Created: src.Created,
+	}
+}
+
+func convertGPGKeyList(src []*gpgKey) []*scm.GPGKey {
+	dst := []*scm.GPGKey{}
+	for _, v := range src {
+		dst = append(dst, convertGPGKey(v))
+	}
+	return dst
+}
+
+func convertGPGKey(src *gpgKey) *scm.GPGKey {
+	dst := &scm.GPGKey{
+		ID:      strconv.Itoa(src.ID),
+		KeyID:   src.KeyID,
+		Key:     src.PublicKey,
+		Created: src.Created,
+		Expires: src.Expires,
+	}
+	for _, v := range src.Emails {
+		dst.Emails = append(dst.Emails, v.Email)
+	}
+	return dst
+}
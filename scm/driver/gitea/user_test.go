@@ -85,3 +85,101 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Want email %s, got %s", want, got)
 	}
 }
+
+func TestUserKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/keys").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListKeys(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Key{}
+	raw, _ := os.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/user/keys").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title: "laptop",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := os.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/user/keys/1").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Users.DeleteKey(context.Background(), "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserGPGKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/gpg_keys").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/gpg_keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListGPGKeys(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := os.ReadFile("testdata/gpg_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -24,6 +25,27 @@ func encodeListOptions(opts scm.ListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("sha", opts.Ref)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -37,6 +59,18 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assigned_by", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("created_by", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.Format(time.RFC3339))
+	}
 	return params.Encode()
 }
 
@@ -6,6 +6,7 @@ package gitea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -50,6 +51,20 @@ func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Labels:   []string{"bug", "ui"},
+		Assignee: "jcitizen",
+		Author:   "octocat",
+		Since:    time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	want := "assigned_by=jcitizen&created_by=octocat&labels=bug%2Cui&since=2017-01-01T00%3A00%3A00Z"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
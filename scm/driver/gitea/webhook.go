@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/hmac"
@@ -28,7 +29,14 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Gitea-Event") {
+	// forgejo sends the gitea headers for compatibility, in
+	// addition to the forgejo headers.
+	event := req.Header.Get("X-Gitea-Event")
+	if event == "" {
+		event = req.Header.Get("X-Forgejo-Event")
+	}
+
+	switch event {
 	case "push":
 		hook, err = s.parsePushHook(data)
 	case "create":
@@ -39,14 +47,41 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseIssueHook(data)
 	case "issue_comment":
 		hook, err = s.parseIssueCommentHook(data)
-	case "pull_request":
+	case "pull_request", "pull_request_label", "pull_request_assign":
 		hook, err = s.parsePullRequestHook(data)
+	case "pull_request_review_approved", "pull_request_review_rejected", "pull_request_review_comment":
+		hook, err = s.parseReviewHook(data)
+	case "release":
+		hook, err = s.parseReleaseHook(data)
+	case "repository":
+		hook, err = s.parseRepositoryHook(data)
+	case "wiki":
+		hook, err = s.parseWikiHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	guid := req.Header.Get("X-Gitea-Delivery")
+	if guid == "" {
+		guid = req.Header.Get("X-Forgejo-Delivery")
+	}
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverGitea,
+		GUID:     guid,
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	// get the gitea signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -60,6 +95,9 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 
 	secret := req.FormValue("secret")
 	signature := req.Header.Get("X-Gitea-Signature")
+	if signature == "" {
+		signature = req.Header.Get("X-Forgejo-Signature")
+	}
 
 	// fail if no signature passed
 	if signature == "" && secret == "" {
@@ -132,11 +170,55 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return convertPullRequestHook(dst), err
 }
 
+func (s *webhookService) parseReviewHook(data []byte) (scm.Webhook, error) {
+	dst := new(pullRequestHook)
+	err := json.Unmarshal(data, dst)
+	return convertReviewHook(dst), err
+}
+
+func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
+	dst := new(releaseHook)
+	err := json.Unmarshal(data, dst)
+	return convertReleaseHook(dst), err
+}
+
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	dst := new(repositoryHook)
+	err := json.Unmarshal(data, dst)
+	return convertRepositoryHook(dst), err
+}
+
+func (s *webhookService) parseWikiHook(data []byte) (scm.Webhook, error) {
+	dst := new(wikiHook)
+	err := json.Unmarshal(data, dst)
+	return convertWikiHook(dst), err
+}
+
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst, nil
+}
+
 //
 // native data structures
 //
 
 type (
+	// gitea webhook payload for unrecognized events
+	rawHook struct {
+		Repository *repository `json:"repository"`
+	}
 	// gitea push webhook payload
 	pushHook struct {
 		Ref        string     `json:"ref"`
@@ -175,6 +257,34 @@ type (
 		PullRequest pr         `json:"pull_request"`
 		Repository  repository `json:"repository"`
 		Sender      user       `json:"sender"`
+		Review      struct {
+			Type    string `json:"type"`
+			Content string `json:"content"`
+		} `json:"review"`
+	}
+
+	// gitea release webhook payload
+	releaseHook struct {
+		Action     string     `json:"action"`
+		Release    release    `json:"release"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// gitea repository webhook payload
+	repositoryHook struct {
+		Action     string     `json:"action"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// gitea wiki webhook payload
+	wikiHook struct {
+		Action     string     `json:"action"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+		Page       string     `json:"page"`
+		Comment    string     `json:"comment"`
 	}
 )
 
@@ -278,32 +388,99 @@ func convertPushHook(dst *pushHook) *scm.PushHook {
 
 func convertPullRequestHook(dst *pullRequestHook) *scm.PullRequestHook {
 	return &scm.PullRequestHook{
-		Action: convertAction(dst.Action),
-		PullRequest: scm.PullRequest{
-			Number: dst.PullRequest.Number,
-			Title:  dst.PullRequest.Title,
-			Body:   dst.PullRequest.Body,
-			Closed: dst.PullRequest.State == "closed",
-			Author: scm.User{
-				Login:  dst.PullRequest.User.Login,
-				Email:  dst.PullRequest.User.Email,
-				Avatar: dst.PullRequest.User.Avatar,
-			},
-			Merged: dst.PullRequest.Merged,
-			// Created: nil,
-			// Updated: nil,
-			Source: dst.PullRequest.Head.Name,
-			Target: dst.PullRequest.Base.Name,
-			Fork:   dst.PullRequest.Head.Repo.FullName,
-			Link:   dst.PullRequest.HTMLURL,
-			Ref:    fmt.Sprintf("refs/pull/%d/head", dst.PullRequest.Number),
+		Action:      convertAction(dst.Action),
+		PullRequest: convertHookPullRequest(dst),
+		Repo:        *convertRepository(&dst.Repository),
+		Sender:      *convertUser(&dst.Sender),
+	}
+}
+
+func convertReviewHook(dst *pullRequestHook) *scm.ReviewHook {
+	return &scm.ReviewHook{
+		Action:      scm.ActionSubmit,
+		PullRequest: convertHookPullRequest(dst),
+		Review: scm.ReviewSubmission{
+			Body:   dst.Review.Content,
+			State:  convertReviewType(dst.Review.Type),
 			Sha:    dst.PullRequest.Head.Sha,
+			Author: *convertUser(&dst.Sender),
 		},
 		Repo:   *convertRepository(&dst.Repository),
 		Sender: *convertUser(&dst.Sender),
 	}
 }
 
+func convertReleaseHook(dst *releaseHook) *scm.ReleaseHook {
+	release := convertRelease(&dst.Release)
+	// the webhook payload includes the html url, which
+	// is preferred over the url derived from the api url.
+	if dst.Release.HTMLURL != "" {
+		release.Link = dst.Release.HTMLURL
+	}
+	return &scm.ReleaseHook{
+		Action:  convertAction(dst.Action),
+		Release: *release,
+		Repo:    *convertRepository(&dst.Repository),
+		Sender:  *convertUser(&dst.Sender),
+	}
+}
+
+func convertRepositoryHook(dst *repositoryHook) *scm.RepositoryHook {
+	return &scm.RepositoryHook{
+		Action: convertAction(dst.Action),
+		Repo:   *convertRepository(&dst.Repository),
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
+func convertWikiHook(dst *wikiHook) *scm.WikiHook {
+	action := convertAction(dst.Action)
+	if dst.Action == "renamed" {
+		action = scm.ActionUpdate
+	}
+	return &scm.WikiHook{
+		Action: action,
+		Page: scm.WikiPage{
+			Title:   dst.Page,
+			Slug:    dst.Page,
+			Message: dst.Comment,
+		},
+		Repo:   *convertRepository(&dst.Repository),
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
+func convertHookPullRequest(dst *pullRequestHook) scm.PullRequest {
+	var labels []scm.Label
+	for _, label := range dst.PullRequest.Labels {
+		labels = append(labels, scm.Label{
+			Name:  label.Name,
+			Color: label.Color,
+		})
+	}
+	return scm.PullRequest{
+		Number: dst.PullRequest.Number,
+		Title:  dst.PullRequest.Title,
+		Body:   dst.PullRequest.Body,
+		Closed: dst.PullRequest.State == "closed",
+		Author: scm.User{
+			Login:  dst.PullRequest.User.Login,
+			Email:  dst.PullRequest.User.Email,
+			Avatar: dst.PullRequest.User.Avatar,
+		},
+		Merged: dst.PullRequest.Merged,
+		// Created: nil,
+		// Updated: nil,
+		Source: dst.PullRequest.Head.Name,
+		Target: dst.PullRequest.Base.Name,
+		Fork:   dst.PullRequest.Head.Repo.FullName,
+		Link:   dst.PullRequest.HTMLURL,
+		Ref:    fmt.Sprintf("refs/pull/%d/head", dst.PullRequest.Number),
+		Sha:    dst.PullRequest.Head.Sha,
+		Labels: labels,
+	}
+}
+
 func convertPullRequestCommentHook(dst *issueHook) *scm.PullRequestCommentHook {
 	return &scm.PullRequestCommentHook{
 		Action:      convertAction(dst.Action),
@@ -355,7 +532,30 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionMerge
 	case "synchronize", "synchronized":
 		return scm.ActionSync
+	case "label_updated":
+		return scm.ActionLabel
+	case "label_cleared":
+		return scm.ActionUnlabel
+	case "assigned":
+		return scm.ActionAssign
+	case "unassigned":
+		return scm.ActionUnassign
+	case "published":
+		return scm.ActionPublish
 	default:
 		return
 	}
 }
+
+func convertReviewType(src string) scm.ReviewState {
+	switch src {
+	case "pull_request_review_approved":
+		return scm.ReviewStateApproved
+	case "pull_request_review_rejected":
+		return scm.ReviewStateChangesRequested
+	case "pull_request_review_comment":
+		return scm.ReviewStateCommented
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/drone/go-scm/scm"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -108,6 +109,25 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merged.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		{
+			event:  "pull_request_label",
+			before: "testdata/webhooks/pull_request_label_updated.json",
+			after:  "testdata/webhooks/pull_request_label_updated.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		{
+			event:  "pull_request_assign",
+			before: "testdata/webhooks/pull_request_assigned.json",
+			after:  "testdata/webhooks/pull_request_assigned.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request review hooks
+		{
+			event:  "pull_request_review_approved",
+			before: "testdata/webhooks/pull_request_review_approved.json",
+			after:  "testdata/webhooks/pull_request_review_approved.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
 		// pull request comment hooks
 		{
 			event:  "issue_comment",
@@ -115,6 +135,34 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_comment_created.json.golden",
 			obj:    new(scm.PullRequestCommentHook),
 		},
+		// release hooks
+		{
+			event:  "release",
+			before: "testdata/webhooks/release_published.json",
+			after:  "testdata/webhooks/release_published.json.golden",
+			obj:    new(scm.ReleaseHook),
+		},
+		// repository hooks
+		{
+			event:  "repository",
+			before: "testdata/webhooks/repository_created.json",
+			after:  "testdata/webhooks/repository_created.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// organization repository hooks
+		{
+			event:  "repository",
+			before: "testdata/webhooks/org_repository_deleted.json",
+			after:  "testdata/webhooks/org_repository_deleted.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// wiki hooks
+		{
+			event:  "wiki",
+			before: "testdata/webhooks/wiki_created.json",
+			after:  "testdata/webhooks/wiki_created.json.golden",
+			obj:    new(scm.WikiHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -149,7 +197,7 @@ func TestWebhooks(t *testing.T) {
 					return
 				}
 
-				if diff := cmp.Diff(test.obj, o); diff != "" {
+				if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 					t.Errorf("Error unmarshaling %s", test.before)
 					t.Log(diff)
 
@@ -227,6 +275,113 @@ func TestWebhook_MissingSignature(t *testing.T) {
 	}
 }
 
+func TestWebhook_ForgejoSignature(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/pull_request_edited.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Forgejo-Event", "pull_request")
+	r.Header.Set("X-Forgejo-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Forgejo-Signature", "a31111f057bafe895837f4a93c0f1f528919c199a20438b1fc8e23485780a33a")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Errorf("Expect valid signature, got %v", err)
+	}
+}
+
+func TestWebhook_ForgejoSignatureInvalid(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/pull_request_edited.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Forgejo-Event", "pull_request")
+	r.Header.Set("X-Forgejo-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Forgejo-Signature", "failfailfailfail")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitea-Event", "fork")
+	r.Header.Set("X-Gitea-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverGitea; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "fork"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "hello-world"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitea-Event", "fork")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitea-Event", "push")
+	r.Header.Set("X-Forgejo-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverGitea,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "push",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
@@ -94,6 +94,10 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 	return convertContentInfoList(out), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type content struct {
 	Name    string `json:"name"`
 	Path    string `json:"path"`
@@ -7,6 +7,7 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -40,6 +41,17 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s", repo, ref)
+	out := new(commit)
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := convertDiff(out.Files)
+	return diff, res, err
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	tags, res, err := s.ListTags(ctx, repo, scm.ListOptions{})
 	if err != nil {
@@ -73,6 +85,13 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	endpoint := fmt.Sprintf("repos/%s/commits?%s", repo, encodeFileCommitListOptions(path, opts))
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/tags", repo)
 	out := []*releasesTags{}
@@ -94,6 +113,48 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/tags", repo)
+	in := &tagCreate{
+		Refs:       params.Sha,
+		TagName:    params.Name,
+		TagMessage: params.Message,
+	}
+	out := new(releasesTags)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTagDetails(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
+type tagCreate struct {
+	Refs       string `json:"refs"`
+	TagName    string `json:"tag_name"`
+	TagMessage string `json:"tag_message,omitempty"`
+}
+
 type branchCreate struct {
 	Refs       string `json:"refs"`
 	BranchName string `json:"branch_name"`
@@ -289,6 +350,15 @@ func convertTag(from *releasesTags) *scm.Reference {
 	}
 }
 
+func convertTagDetails(from *releasesTags) *scm.Tag {
+	return &scm.Tag{
+		Name:    from.Name,
+		Path:    scm.ExpandRef(from.Name, "refs/tags"),
+		Message: from.Message,
+		Sha:     from.Commit.Sha,
+	}
+}
+
 func convertChangeList(from []*file) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
@@ -297,6 +367,23 @@ func convertChangeList(from []*file) []*scm.Change {
 	return to
 }
 
+// convertDiff joins the per-file patches into a unified
+// diff. Gitee does not return file modes or the previous
+// path of renamed files for commits.
+func convertDiff(from []*file) (*scm.Diff, error) {
+	var b strings.Builder
+	for _, v := range from {
+		file := &scm.FileDiff{
+			OldPath: v.Filename,
+			NewPath: v.Filename,
+			Added:   v.Status == "added",
+			Deleted: v.Status == "removed",
+		}
+		b.WriteString(scm.FormatFileDiff(file, v.Patch))
+	}
+	return scm.ParseDiff(b.String())
+}
+
 func convertChange(from *file) *scm.Change {
 	return &scm.Change{
 		Path:    from.Filename,
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -129,6 +130,54 @@ func TestGitFindTag(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/tags").
+		JSON(map[string]string{
+			"refs":        "5e7876efb3468ff679410b82a72f7c002382d41e",
+			"tag_name":    "1.0",
+			"tag_message": "1.0",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag.json")
+
+	input := &scm.TagInput{
+		Name:    "1.0",
+		Sha:     "5e7876efb3468ff679410b82a72f7c002382d41e",
+		Message: "1.0",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.CreateTag(context.Background(), "kit101/drone-yml-test", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Git.DeleteTag(context.Background(), "kit101/drone-yml-test", "1.0")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -191,6 +240,47 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/commits").
+		MatchParam("path", ".drone.yml").
+		MatchParam("sha", "master").
+		MatchParam("since", "2021-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{
+		Ref:   "master",
+		Since: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Page:  1,
+		Size:  3,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.ListFileCommits(context.Background(), "kit101/drone-yml-test", ".drone.yml", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Page", testPage(res))
+}
+
 func TestGitListTags(t *testing.T) {
 	defer gock.Off()
 
@@ -249,6 +339,35 @@ func TestGitListChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/commits/e3c0ff4d5cef439ea11b30866fb1ed79b420801d").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindCommitDiff(context.Background(), "kit101/drone-yml-test", "e3c0ff4d5cef439ea11b30866fb1ed79b420801d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit_diff.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestGitCompareChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -31,12 +31,15 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitee
 	client.Linker = &linker{websiteAddress(base)}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &RepositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -7,7 +7,9 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -48,16 +50,21 @@ func (s *issueService) ListComments(ctx context.Context, repo string, number int
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	owner, repoName := scm.Split(repo)
 	path := fmt.Sprintf("repos/%s/issues", owner)
-	in := &issueInput{
-		Repo:  repoName,
-		Title: input.Title,
-		Body:  input.Body,
-	}
+	in := convertFromIssueInput(repoName, input)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	in := convertFromIssueInput(repoName, input)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%s/comments", repo, decodeNumber(number))
 	in := &issueCommentInput{
@@ -85,6 +92,52 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	data := map[string]string{
+		"repo":  repoName,
+		"state": "open",
+	}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%s/labels", repo, decodeNumber(number))
+	return s.client.do(ctx, "POST", path, &labels, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%s/labels/%s", repo, decodeNumber(number), url.PathEscape(label))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// SetAssignees sets the issue assignee and collaborators. Gitee
+// issues have a single assignee, so the first login is used as
+// the assignee and the remaining logins as collaborators.
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	in := &issueAssigneesInput{Repo: repoName}
+	if len(logins) != 0 {
+		in.Assignee = logins[0]
+		in.Collaborators = strings.Join(logins[1:], ",")
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	in := &issueMilestoneInput{
+		Repo:      repoName,
+		Milestone: milestone,
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 func (s *issueService) Lock(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -217,9 +270,24 @@ type issueStateDetail struct {
 }
 
 type issueInput struct {
-	Repo  string `json:"repo"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Repo          string `json:"repo"`
+	Title         string `json:"title,omitempty"`
+	Body          string `json:"body,omitempty"`
+	Labels        string `json:"labels,omitempty"`
+	Assignee      string `json:"assignee,omitempty"`
+	Collaborators string `json:"collaborators,omitempty"`
+	Milestone     int    `json:"milestone,omitempty"`
+}
+
+type issueAssigneesInput struct {
+	Repo          string `json:"repo"`
+	Assignee      string `json:"assignee"`
+	Collaborators string `json:"collaborators"`
+}
+
+type issueMilestoneInput struct {
+	Repo      string `json:"repo"`
+	Milestone int    `json:"milestone"`
 }
 
 type issueComment struct {
@@ -239,6 +307,23 @@ type issueCommentInput struct {
 	Body string `json:"body"`
 }
 
+// helper function to convert from the common issue input
+// to the gitee issue input.
+func convertFromIssueInput(repo string, from *scm.IssueInput) *issueInput {
+	to := &issueInput{
+		Repo:      repo,
+		Title:     from.Title,
+		Body:      from.Body,
+		Labels:    strings.Join(from.Labels, ","),
+		Milestone: from.Milestone,
+	}
+	if len(from.Assignees) != 0 {
+		to.Assignee = from.Assignees[0]
+		to.Collaborators = strings.Join(from.Assignees[1:], ",")
+	}
+	return to
+}
+
 func convertIssueList(from []*issue) []*scm.Issue {
 	to := []*scm.Issue{}
 	for _, v := range from {
@@ -265,6 +265,153 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]interface{}{
+			"repo":          "drone-yml-test",
+			"title":         "test issue 1",
+			"labels":        "bug,feature",
+			"assignee":      "kit101",
+			"collaborators": "gitee",
+			"milestone":     1,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := &scm.IssueInput{
+		Title:     "test issue 1",
+		Labels:    []string{"bug", "feature"},
+		Assignees: []string{"kit101", "gitee"},
+		Milestone: 1,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "kit101/drone-yml-test", 735267685380, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := os.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]string{"repo": "drone-yml-test", "state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "kit101/drone-yml-test", 735267685380)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueAddLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/issues/I4CD5P/labels").
+		JSON([]string{"bug", "feature"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id": 1, "name": "bug"}, {"id": 2, "name": "feature"}]`)
+
+	client := NewDefault()
+	res, err := client.Issues.AddLabels(context.Background(), "kit101/drone-yml-test", 735267685380, []string{"bug", "feature"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test/issues/I4CD5P/labels/bug").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.RemoveLabel(context.Background(), "kit101/drone-yml-test", 735267685380, "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]string{"repo": "drone-yml-test", "assignee": "", "collaborators": ""}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.SetAssignees(context.Background(), "kit101/drone-yml-test", 735267685380, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueSetMilestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]interface{}{"repo": "drone-yml-test", "milestone": 1}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.SetMilestone(context.Background(), "kit101/drone-yml-test", 735267685380, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	_, err := NewDefault().Issues.Lock(context.Background(), "kit101/drone-yml-test", 735267685380)
 	if err != scm.ErrNotSupported {
@@ -0,0 +1,100 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: strings.TrimPrefix(input.Color, "#"),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelInput{
+		Name:  input.Name,
+		Color: strings.TrimPrefix(input.Color, "#"),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddToIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabels(ctx, repo, number, []string{name})
+}
+
+func (s *labelService) RemoveFromIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, name)
+}
+
+func (s *labelService) AddToPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/labels", repo, number)
+	in := []string{name}
+	return s.client.do(ctx, "POST", path, &in, nil)
+}
+
+func (s *labelService) RemoveFromPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/labels/%s", repo, number, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type labelInput struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:  from.Name,
+		Color: strings.TrimPrefix(from.Color, "#"),
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/labels").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	got, res, err := client.Labels.List(context.Background(), "kit101/drone-yml-test", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := os.ReadFile("testdata/labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestLabelUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/drone-yml-test/labels/defect").
+		JSON(map[string]string{"name": "bug", "color": "d73a4a"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id": 108269, "name": "bug", "color": "d73a4a"}`)
+
+	input := &scm.LabelInput{Name: "bug", Color: "#d73a4a"}
+
+	client := NewDefault()
+	got, res, err := client.Labels.Update(context.Background(), "kit101/drone-yml-test", "defect", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "bug" || got.Color != "d73a4a" {
+		t.Errorf("Unexpected label %s with color %s", got.Name, got.Color)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestLabelAddToPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/pulls/1/labels").
+		JSON([]string{"bug"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	res, err := client.Labels.AddToPullRequest(context.Background(), "kit101/drone-yml-test", 1, "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestLabelRemoveFromPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test/pulls/1/labels/bug").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Labels.RemoveFromPullRequest(context.Background(), "kit101/drone-yml-test", 1, "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
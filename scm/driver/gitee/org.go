@@ -36,6 +36,26 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 type organization struct {
 	ID          int    `json:"id"`
 	Login       string `json:"login"`
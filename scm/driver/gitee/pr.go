@@ -7,6 +7,7 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -44,6 +45,17 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertPrChangeList(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/files", repo, number)
+	out := []*prFile{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := convertPrDiff(out)
+	return diff, res, err
+}
+
 func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/comments/?%s", repo, number, encodeListOptions(opts))
 	out := []*prComment{}
@@ -64,6 +76,23 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	// gitee does not support fast-forward merges or verifying
+	// the expected head commit of the pull request.
+	if input.Method == scm.MergeMethodFastForward || input.Sha != "" {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/merge", repo, number)
+	in := &mergeInput{
+		MergeMethod:       convertFromMergeMethod(input.Method),
+		PruneSourceBranch: input.DeleteSourceBranch,
+		Title:             input.CommitTitle,
+		Description:       input.CommitMessage,
+	}
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	return res, err
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -308,6 +337,13 @@ type prInput struct {
 	Head  string `json:"head"`
 	Base  string `json:"base"`
 }
+type mergeInput struct {
+	MergeMethod       string `json:"merge_method,omitempty"`
+	PruneSourceBranch bool   `json:"prune_source_branch,omitempty"`
+	Title             string `json:"title,omitempty"`
+	Description       string `json:"description,omitempty"`
+}
+
 type prCommentInput struct {
 	Body string `json:"body"`
 }
@@ -386,6 +422,19 @@ func convertPullRequestComment(from *prComment) *scm.Comment {
 	}
 }
 
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodMerge:
+		return "merge"
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	default:
+		return ""
+	}
+}
+
 func convertPrChangeList(from []*prFile) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
@@ -403,6 +452,30 @@ func convertPrChange(from *prFile) *scm.Change {
 	}
 }
 
+// convertPrDiff joins the per-file patches into a unified
+// diff. Gitee reports a zero mode for the missing side of
+// added and deleted files.
+func convertPrDiff(from []*prFile) (*scm.Diff, error) {
+	var b strings.Builder
+	for _, v := range from {
+		file := &scm.FileDiff{
+			OldPath: v.Patch.OldPath,
+			NewPath: v.Patch.NewPath,
+			Added:   v.Patch.NewFile,
+			Deleted: v.Patch.DeletedFile,
+			Renamed: v.Patch.RenamedFile,
+		}
+		if v.Patch.AMode != "0" {
+			file.OldMode = v.Patch.AMode
+		}
+		if v.Patch.BMode != "0" {
+			file.NewMode = v.Patch.BMode
+		}
+		b.WriteString(scm.FormatFileDiff(file, v.Patch.Diff))
+	}
+	return scm.ParseDiff(b.String())
+}
+
 func convertPrCommitList(from []*prCommit) []*scm.Commit {
 	to := []*scm.Commit{}
 	for _, v := range from {
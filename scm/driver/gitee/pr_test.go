@@ -135,6 +135,35 @@ func TestPullListChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/pulls/6/files").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_files.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.FindDiff(context.Background(), "kit101/drone-yml-test", 6)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/pr_diff.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestPullListComments(t *testing.T) {
 	defer gock.Off()
 
@@ -212,6 +241,36 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Put("/repos/kit101/drone-yml-test/pulls/6/merge").
+		JSON(map[string]interface{}{
+			"merge_method":        "squash",
+			"prune_source_branch": true,
+			"title":               "Squash feature",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Squash feature",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.MergeWithOptions(context.Background(), "kit101/drone-yml-test", 6, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -98,6 +98,95 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository.
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// gitee does not support internal repositories or
+	// setting the default branch on create.
+	if input.Visibility == scm.VisibilityInternal || input.Branch != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:              input.Name,
+		Description:       input.Description,
+		AutoInit:          input.Readme,
+		GitignoreTemplate: input.Gitignore,
+	}
+	if input.Visibility == scm.VisibilityPrivate {
+		private := true
+		in.Private = &private
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Fork forks the repository into the namespace.
+func (s *RepositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/forks", repo)
+	in := &forkInput{Organization: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates the repository settings.
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Visibility == scm.VisibilityInternal || input.Archived {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositoryInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+	}
+	// gitee requires the repository name when updating
+	// the repository settings.
+	if in.Name == "" {
+		_, in.Name = scm.Split(repo)
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		private := input.Visibility == scm.VisibilityPrivate
+		in.Private = &private
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Archive returns ErrNotSupported; gitee does not expose
+// repository archiving in its api.
+func (s *RepositoryService) Archive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Unarchive returns ErrNotSupported.
+func (s *RepositoryService) Unarchive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Delete deletes the repository.
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type repository struct {
 	ID    int `json:"id"`
 	Owner struct {
@@ -128,6 +217,19 @@ type repository struct {
 	} `json:"permission"`
 }
 
+type repositoryInput struct {
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	Private           *bool  `json:"private,omitempty"`
+	DefaultBranch     string `json:"default_branch,omitempty"`
+	AutoInit          bool   `json:"auto_init,omitempty"`
+	GitignoreTemplate string `json:"gitignore_template,omitempty"`
+}
+
+type forkInput struct {
+	Organization string `json:"organization,omitempty"`
+}
+
 type hook struct {
 	ID        int       `json:"id"`
 	URL       string    `json:"url"`
@@ -274,6 +274,149 @@ func TestRepositoryDeleteHook(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/user/repos").
+		JSON(map[string]interface{}{
+			"name":               "drone-yml-test",
+			"private":            true,
+			"auto_init":          true,
+			"gitignore_template": "Go",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Name:       "drone-yml-test",
+		Visibility: scm.VisibilityPrivate,
+		Readme:     true,
+		Gitignore:  "Go",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/forks").
+		JSON(map[string]interface{}{"organization": "drone"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Fork(context.Background(), "kit101/drone-yml-test", "drone")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/drone-yml-test").
+		JSON(map[string]interface{}{
+			"name":           "drone-yml-test",
+			"description":    "drone yaml test",
+			"private":        false,
+			"default_branch": "master",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Description: "drone yaml test",
+		Branch:      "master",
+		Visibility:  scm.VisibilityPublic,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Update(context.Background(), "kit101/drone-yml-test", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestRepositoryArchive(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Repositories.Archive(context.Background(), "kit101/drone-yml-test")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.Delete(context.Background(), "kit101/drone-yml-test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	t.Run("Request", testRequest(res))
+}
+
 func TestRepositoryNotFound(t *testing.T) {
 	defer gock.Off()
 
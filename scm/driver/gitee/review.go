@@ -29,3 +29,35 @@ func (s *reviewService) Create(context.Context, string, int, *scm.ReviewInput) (
 func (s *reviewService) Delete(context.Context, string, int, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -0,0 +1,267 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that gitee sends, which can be used to test webhook
+// handlers without a connection to gitee. The request is
+// signed with the current timestamp if the secret is not
+// empty. ErrNotSupported is returned for other hook types
+// and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event = "Push Hook"
+		if scm.IsTag(v.Ref) {
+			event = "Tag Push Hook"
+		}
+		payload = simulatePushHook(v)
+	case *scm.PullRequestHook:
+		action, desc, ok := simulateAction(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		event, payload = "Merge Request Hook", simulatePullRequestHook(v, action, desc)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "git-oschina-hook")
+	req.Header.Set("X-Gitee-Event", event)
+	if secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		req.Header.Set("X-Gitee-Timestamp", timestamp)
+		req.Header.Set("X-Gitee-Token", simulateSignature(secret, timestamp))
+	}
+	return req, nil
+}
+
+// helper function returns the gitee signature, which is
+// computed from the timestamp and secret instead of the
+// payload.
+// see https://gitee.com/help/articles/4290#article-header3
+func simulateSignature(key, timestamp string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(timestamp + "\n" + key))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) *pushOrTagPushHook {
+	dst := &pushOrTagPushHook{
+		HookName:          "push_hooks",
+		Ref:               src.Ref,
+		Before:            src.Before,
+		After:             src.After,
+		Compare:           src.Commit.Link,
+		Commits:           []hookCommit{},
+		HeadCommit:        simulateCommit(&src.Commit),
+		TotalCommitsCount: len(src.Commits),
+		Repository:        *simulateRepository(&src.Repo),
+		Sender:            *simulateUser(&src.Sender),
+	}
+	if dst.After == "" {
+		dst.After = src.Commit.Sha
+	}
+	for _, c := range src.Commits {
+		dst.Commits = append(dst.Commits, simulateCommit(&c))
+	}
+	return dst
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, action, desc string) *mergeRequestHook {
+	pull := &src.PullRequest
+	state := "open"
+	switch {
+	case pull.Merged || src.Action == scm.ActionMerge:
+		state = "merged"
+	case pull.Closed || src.Action == scm.ActionClose:
+		state = "closed"
+	}
+	repo := simulateRepository(&src.Repo)
+	head := prRepo{
+		ID:       repo.ID,
+		FullName: repo.FullName,
+		Path:     repo.Path,
+		Name:     repo.Name,
+		Private:  repo.Private,
+		Public:   !repo.Private,
+		HtmlURL:  repo.HtmlURL,
+		SshURL:   repo.SSHURL,
+	}
+	base := head
+	if pull.Fork != "" {
+		head.FullName = pull.Fork
+		_, head.Name = scm.Split(pull.Fork)
+		head.Path = head.Name
+	}
+	dst := &pr{
+		HtmlURL:   pull.Link,
+		DiffURL:   pull.Diff,
+		Number:    pull.Number,
+		State:     state,
+		Title:     pull.Title,
+		Body:      pull.Body,
+		Labels:    []label{},
+		CreatedAt: pull.Created,
+		UpdatedAt: pull.Updated,
+		Head: headOrBase{
+			Label: pull.Source,
+			Ref:   pull.Source,
+			Sha:   pull.Sha,
+			Repo:  head,
+		},
+		Base: headOrBase{
+			Label: pull.Target,
+			Ref:   pull.Target,
+			Sha:   pull.Base.Sha,
+			Repo:  base,
+		},
+		User: user{
+			Login:     pull.Author.Login,
+			Name:      pull.Author.Name,
+			AvatarURL: pull.Author.Avatar,
+		},
+	}
+	for _, l := range pull.Labels {
+		dst.Labels = append(dst.Labels, label{
+			Name:  l.Name,
+			Color: l.Color,
+		})
+	}
+	return &mergeRequestHook{
+		Action:         action,
+		ActionDesc:     desc,
+		Number:         pull.Number,
+		Iid:            pull.Number,
+		Title:          pull.Title,
+		Body:           pull.Body,
+		State:          state,
+		URL:            pull.Link,
+		SourceBranch:   pull.Source,
+		TargetBranch:   pull.Target,
+		MergeCommitSha: pull.Merge,
+		Author:         dst.User,
+		PullRequest:    *dst,
+		Project:        *repo,
+		Repository:     *repo,
+		Sender:         *simulateUser(&src.Sender),
+		UpdatedBy:      *simulateUser(&src.Sender),
+	}
+}
+
+func simulateCommit(src *scm.Commit) hookCommit {
+	return hookCommit{
+		ID:        src.Sha,
+		Distinct:  true,
+		Message:   src.Message,
+		Timestamp: src.Author.Date,
+		URL:       src.Link,
+		Author: hookAuthorOrCommitter{
+			Time:     src.Author.Date,
+			Name:     src.Author.Name,
+			Email:    src.Author.Email,
+			Username: src.Author.Login,
+			UserName: src.Author.Login,
+		},
+		Committer: hookAuthorOrCommitter{
+			Time:     src.Committer.Date,
+			Name:     src.Committer.Name,
+			Email:    src.Committer.Email,
+			Username: src.Committer.Login,
+			UserName: src.Committer.Login,
+		},
+		Added:    []string{},
+		Removed:  []string{},
+		Modified: []string{},
+	}
+}
+
+func simulateRepository(src *scm.Repository) *hookRepository {
+	id, _ := strconv.Atoi(src.ID)
+	return &hookRepository{
+		ID:                id,
+		Name:              src.Name,
+		Path:              src.Name,
+		FullName:          scm.Join(src.Namespace, src.Name),
+		Namespace:         src.Namespace,
+		PathWithNamespace: scm.Join(src.Namespace, src.Name),
+		NameWithNamespace: scm.Join(src.Namespace, src.Name),
+		Owner: user{
+			Login: src.Namespace,
+		},
+		Private:       src.Private,
+		DefaultBranch: src.Branch,
+		Description:   src.Description,
+		HtmlURL:       src.Link,
+		URL:           src.Link,
+		CloneURL:      src.Clone,
+		GitHttpURL:    src.Clone,
+		GitSshURL:     src.CloneSSH,
+		SSHURL:        src.CloneSSH,
+		CreatedAt:     src.Created,
+		UpdatedAt:     src.Updated,
+	}
+}
+
+func simulateUser(src *scm.User) *user {
+	id, _ := strconv.Atoi(src.ID)
+	return &user{
+		ID:        id,
+		Login:     src.Login,
+		Name:      src.Name,
+		Email:     src.Email,
+		AvatarURL: src.Avatar,
+		CreatedAt: src.Created,
+		UpdatedAt: src.Updated,
+	}
+}
+
+// helper function returns the native merge request action
+// and action description.
+func simulateAction(from scm.Action) (string, string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "open", "", true
+	case scm.ActionClose:
+		return "close", "", true
+	case scm.ActionMerge:
+		return "merge", "", true
+	case scm.ActionSync:
+		return "update", "source_branch_changed", true
+	case scm.ActionLabel, scm.ActionUnlabel:
+		return "update", "update_label", true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "15875464",
+	Namespace: "kit101",
+	Name:      "drone-yml-test",
+	Branch:    "master",
+	Clone:     "https://gitee.com/kit101/drone-yml-test.git",
+	CloneSSH:  "git@gitee.com:kit101/drone-yml-test.git",
+	Link:      "https://gitee.com/kit101/drone-yml-test",
+}
+
+var simulateSender = scm.User{
+	Login:  "kit101",
+	Name:   "kit101",
+	Email:  "kit101@gitee.com",
+	Avatar: "https://portrait.gitee.com/uploads/avatars/user/511/1555342_kit101_1592792439.png",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Login: "kit101",
+		Name:  "kit101",
+		Email: "kit101@gitee.com",
+		Date:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "b2cb4e5e7d6e4e2c9a4e2b7e0c3f3c5d1a0e2f3b",
+			After:  "7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+			Commit: scm.Commit{
+				Sha:       "7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+				Message:   "update readme",
+				Link:      "https://gitee.com/kit101/drone-yml-test/compare/b2cb4e5e7d6e4e2c9a4e2b7e0c3f3c5d1a0e2f3b...7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+					Message:   "update readme",
+					Link:      "https://gitee.com/kit101/drone-yml-test/commit/7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Body:   "adding build instructions to readme",
+				Sha:    "7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+				Ref:    "refs/pull/1/head",
+				Source: "feature",
+				Target: "master",
+				Fork:   "kit101/drone-yml-test",
+				Link:   "https://gitee.com/kit101/drone-yml-test/pulls/1",
+				Diff:   "https://gitee.com/kit101/drone-yml-test/pulls/1.diff",
+				Closed: true,
+				Merged: true,
+				Head: scm.Reference{
+					Name: "feature",
+					Path: "refs/heads/feature",
+					Sha:  "7b2a6f4a1c8b1d3f0b3e6c5c7d9b8a5e4f3d2c1b",
+				},
+				Base: scm.Reference{
+					Name: "master",
+					Path: "refs/heads/master",
+					Sha:  "b2cb4e5e7d6e4e2c9a4e2b7e0c3f3c5d1a0e2f3b",
+				},
+				Author: scm.User{
+					Login:  "kit101",
+					Avatar: "https://portrait.gitee.com/uploads/avatars/user/511/1555342_kit101_1592792439.png",
+				},
+				Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Updated: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
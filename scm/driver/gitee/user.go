@@ -41,6 +41,22 @@ func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *userService) ListKeys(context.Context, scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) CreateKey(context.Context, *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) DeleteKey(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListGPGKeys(context.Context, scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type user struct {
 	ID                int       `json:"id"`
 	Login             string    `json:"login"`
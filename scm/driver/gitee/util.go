@@ -7,6 +7,8 @@ package gitee
 import (
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -36,6 +38,27 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("sha", opts.Ref)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -52,6 +75,25 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Open {
 		params.Set("state", "open")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("creator", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.Format(time.RFC3339))
+	}
+	// sort: created, updated; default: created
+	switch opts.Sort {
+	case scm.IssueSortCreated:
+		params.Set("sort", "created")
+	case scm.IssueSortUpdated:
+		params.Set("sort", "updated")
+	}
 	return params.Encode()
 }
 
@@ -6,6 +6,7 @@ package gitee
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -63,6 +64,21 @@ func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Labels:   []string{"bug", "feature"},
+		Assignee: "kit101",
+		Author:   "gitee",
+		Since:    time.Date(2021, 9, 10, 8, 0, 0, 0, time.UTC),
+		Sort:     scm.IssueSortUpdated,
+	}
+	want := "assignee=kit101&creator=gitee&labels=bug%2Cfeature&since=2021-09-10T08%3A00%3A00Z&sort=updated"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
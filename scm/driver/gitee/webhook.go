@@ -30,7 +30,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Gitee-Event") {
+	event := req.Header.Get("X-Gitee-Event")
+	switch event {
 	case "Push Hook":
 		hook, err = s.parsePushHook(data)
 	case "Merge Request Hook":
@@ -42,11 +43,25 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "Tag Push Hook":
 		hook, err = s.parseTagPushHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverGitee,
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	key, err := fn(hook)
 	if err != nil {
@@ -110,7 +125,28 @@ func validateSignature(signature, key, timestamp string) bool {
 	return computedSignature == signature
 }
 
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	// gitee webhooks do not include a delivery identifier.
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertHookRepository(src.Repository)
+	}
+	return dst, nil
+}
+
 type (
+	// gitee webhook payload for unrecognized events
+	rawHook struct {
+		Repository *hookRepository `json:"repository"`
+	}
 	pushOrTagPushHook struct {
 		Action             string         `json:"action"`
 		HookName           string         `json:"hook_name"`
@@ -15,6 +15,7 @@ import (
 	"github.com/drone/go-scm/scm"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -201,7 +202,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 		}
@@ -260,6 +261,99 @@ func TestWebhookValid(t *testing.T) {
 	}
 }
 
+// regression test for commit comments, which are not
+// supported and must be ignored without panicking.
+func TestWebhook_CommitComment(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/note_hook_commit_comment.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Note Hook")
+	r.Header.Set("X-Gitee-Token", "Xvh4YPVe6l31XpDRL9J2yeaEXabsckIoUUschpXiVck=")
+	r.Header.Set("X-Gitee-Timestamp", "1633679083918")
+	r.Header.Set("User-Agent", "git-oschina-hook")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+	}
+	if o != nil {
+		t.Errorf("Expect nil hook for commit comment, got %T", o)
+	}
+}
+
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Star Hook")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverGitee; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "Star Hook"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "drone-yml-test"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Star Hook")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Push Hook")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverGitee,
+		Event:  "Push Hook",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "bBg5lrt03VixkX85CNqYIcecC0SIGASE", nil
 }
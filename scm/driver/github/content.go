@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -99,6 +100,57 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 	return convertContentInfoList(out), res, err
 }
 
+// blameQuery is the graphql query used to fetch the blame
+// of a file at the given revision.
+const blameQuery = `query($owner: String!, $name: String!, $ref: String!, $path: String!) {
+  repository(owner: $owner, name: $name) {
+    object(expression: $ref) {
+      ... on Commit {
+        blame(path: $path) {
+          ranges {
+            startingLine
+            endingLine
+            commit {
+              oid
+              message
+              url
+              author { name email date user { login avatarUrl } }
+              committer { name email date user { login avatarUrl } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// Blame returns the blame of the file. The rest api does not
+// support blame, so the graphql api is used instead.
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	owner, name := scm.Split(repo)
+	in := &graphqlInput{
+		Query: blameQuery,
+		Variables: map[string]interface{}{
+			"owner": owner,
+			"name":  name,
+			"ref":   ref,
+			"path":  path,
+		},
+	}
+	out := new(blame)
+	res, err := s.client.graphql(ctx, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if out.Repository.Object == nil {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertBlameRangeList(out.Repository.Object.Blame.Ranges), res, nil
+}
+
 type content struct {
 	Name    string `json:"name"`
 	Path    string `json:"path"`
@@ -147,3 +199,66 @@ func convertContentInfo(from *content) *scm.ContentInfo {
 	}
 	return to
 }
+
+type blame struct {
+	Repository struct {
+		Object *struct {
+			Blame struct {
+				Ranges []*blameRange `json:"ranges"`
+			} `json:"blame"`
+		} `json:"object"`
+	} `json:"repository"`
+}
+
+type blameRange struct {
+	StartingLine int `json:"startingLine"`
+	EndingLine   int `json:"endingLine"`
+	Commit       struct {
+		Oid       string         `json:"oid"`
+		Message   string         `json:"message"`
+		URL       string         `json:"url"`
+		Author    blameSignature `json:"author"`
+		Committer blameSignature `json:"committer"`
+	} `json:"commit"`
+}
+
+type blameSignature struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+	User  *struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatarUrl"`
+	} `json:"user"`
+}
+
+func convertBlameRangeList(from []*blameRange) []*scm.BlameRange {
+	to := []*scm.BlameRange{}
+	for _, v := range from {
+		to = append(to, &scm.BlameRange{
+			StartLine: v.StartingLine,
+			EndLine:   v.EndingLine,
+			Commit: scm.Commit{
+				Sha:       v.Commit.Oid,
+				Message:   v.Commit.Message,
+				Link:      v.Commit.URL,
+				Author:    convertBlameSignature(v.Commit.Author),
+				Committer: convertBlameSignature(v.Commit.Committer),
+			},
+		})
+	}
+	return to
+}
+
+func convertBlameSignature(from blameSignature) scm.Signature {
+	to := scm.Signature{
+		Name:  from.Name,
+		Email: from.Email,
+		Date:  from.Date,
+	}
+	if from.User != nil {
+		to.Login = from.User.Login
+		to.Avatar = from.User.AvatarURL
+	}
+	return to
+}
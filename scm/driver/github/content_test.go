@@ -260,3 +260,59 @@ func TestContentList(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestContentBlame(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": blameQuery,
+			"variables": map[string]interface{}{
+				"owner": "octocat",
+				"name":  "hello-world",
+				"ref":   "master",
+				"path":  "README",
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blame.json")
+
+	client := NewDefault()
+	got, res, err := client.Contents.Blame(context.Background(), "octocat/hello-world", "README", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.BlameRange{}
+	raw, _ := os.ReadFile("testdata/blame.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestContentBlameNotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data": {"repository": {"object": null}}}`)
+
+	client := NewDefault()
+	_, _, err := client.Contents.Blame(context.Background(), "octocat/hello-world", "README", "")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo, id string) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%s", repo, id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments?%s", repo, encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments", repo)
+	in := &deploymentInput{
+		Ref:                   input.Ref,
+		Task:                  input.Task,
+		Environment:           input.Environment,
+		Description:           input.Desc,
+		Payload:               input.Payload,
+		ProductionEnvironment: input.Production,
+		TransientEnvironment:  input.Transient,
+	}
+	if in.Ref == "" {
+		in.Ref = input.Sha
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo, id string, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%s/statuses", repo, id)
+	in := &deployStatus{
+		State:          convertFromDeployState(input.State),
+		Environment:    input.Environment,
+		EnvironmentURL: input.EnvironmentURL,
+		Description:    input.Desc,
+		TargetURL:      input.Target,
+	}
+	out := new(deployStatus)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+func (s *deploymentService) ListStatuses(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%s/statuses?%s", repo, id, encodeListOptions(opts))
+	out := []*deployStatus{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeployStatusList(out), res, err
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments?%s", repo, encodeListOptions(opts))
+	out := new(environmentList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertEnvironmentList(out.Environments), res, err
+}
+
+type deployment struct {
+	ID          int64       `json:"id"`
+	Sha         string      `json:"sha"`
+	Ref         string      `json:"ref"`
+	Task        string      `json:"task"`
+	Payload     interface{} `json:"payload"`
+	Environment string      `json:"environment"`
+	Description string      `json:"description"`
+	Creator     struct {
+		ID        int    `json:"id"`
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"creator"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type deploymentInput struct {
+	Ref                   string      `json:"ref"`
+	Task                  string      `json:"task,omitempty"`
+	Environment           string      `json:"environment,omitempty"`
+	Description           string      `json:"description,omitempty"`
+	Payload               interface{} `json:"payload,omitempty"`
+	ProductionEnvironment bool        `json:"production_environment,omitempty"`
+	TransientEnvironment  bool        `json:"transient_environment,omitempty"`
+}
+
+type environment struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type environmentList struct {
+	TotalCount   int            `json:"total_count"`
+	Environments []*environment `json:"environments"`
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	return &scm.Deployment{
+		ID:          strconv.FormatInt(from.ID, 10),
+		Ref:         from.Ref,
+		Sha:         from.Sha,
+		Task:        from.Task,
+		Environment: from.Environment,
+		Desc:        from.Description,
+		Author: scm.User{
+			ID:     strconv.Itoa(from.Creator.ID),
+			Login:  from.Creator.Login,
+			Avatar: from.Creator.AvatarURL,
+		},
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+func convertDeployStatusList(from []*deployStatus) []*scm.DeployStatus {
+	to := []*scm.DeployStatus{}
+	for _, v := range from {
+		to = append(to, convertDeployStatus(v))
+	}
+	return to
+}
+
+func convertEnvironmentList(from []*environment) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *environment) *scm.Environment {
+	return &scm.Environment{
+		ID:      strconv.FormatInt(from.ID, 10),
+		Name:    from.Name,
+		Link:    from.HTMLURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+// helper function converts the deployment status state,
+// which includes the queued and in_progress states that
+// are not used by commit statuses.
+func convertDeployState(from string) scm.State {
+	switch from {
+	case "queued":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	default:
+		return convertState(from)
+	}
+}
+
+func convertFromDeployState(from scm.State) string {
+	switch from {
+	case scm.StatePending:
+		return "pending"
+	case scm.StateRunning:
+		return "in_progress"
+	case scm.StateSuccess:
+		return "success"
+	case scm.StateFailure:
+		return "failure"
+	case scm.StateCanceled:
+		return "inactive"
+	default:
+		return "error"
+	}
+}
@@ -7,9 +7,11 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
 )
 
 type gitService struct {
@@ -25,6 +27,50 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	tag := &scm.Tag{
+		Name: params.Name,
+		Path: scm.ExpandRef(params.Name, "refs/tags"),
+		Sha:  params.Sha,
+	}
+	// an annotated tag requires a tag object, which is
+	// then referenced by the tag ref.
+	target := params.Sha
+	if params.Message != "" {
+		path := fmt.Sprintf("repos/%s/git/tags", repo)
+		in := &tagInput{
+			Tag:     params.Name,
+			Message: params.Message,
+			Object:  params.Sha,
+			Type:    "commit",
+		}
+		out := new(tagObject)
+		res, err := s.client.do(ctx, "POST", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		tag = convertTagObject(out)
+		target = out.Sha
+	}
+	path := fmt.Sprintf("repos/%s/git/refs", repo)
+	in := &createBranch{
+		Ref: tag.Path,
+		Sha: target,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return tag, res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -39,6 +85,11 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s", repo, ref)
+	return s.client.diff(ctx, path)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/git/ref/tags/%s", repo, name)
 	out := new(ref)
@@ -46,6 +97,42 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertRef(out), res, err
 }
 
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/ref/tags/%s", repo, name)
+	out := new(ref)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if out.Object.Type != "tag" {
+		return &scm.Tag{
+			Name: scm.TrimRef(out.Ref),
+			Path: out.Ref,
+			Sha:  out.Object.Sha,
+		}, res, nil
+	}
+	path = fmt.Sprintf("repos/%s/git/tags/%s", repo, out.Object.Sha)
+	obj := new(tagObject)
+	res, err = s.client.do(ctx, "GET", path, nil, obj)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertTagObject(obj)
+	// an annotated tag can point to another annotated tag,
+	// so the tag objects are peeled until the commit is
+	// reached.
+	for next := obj; next.Object.Type == "tag"; {
+		path = fmt.Sprintf("repos/%s/git/tags/%s", repo, next.Object.Sha)
+		next = new(tagObject)
+		res, err = s.client.do(ctx, "GET", path, nil, next)
+		if err != nil {
+			return nil, res, err
+		}
+		to.Sha = next.Object.Sha
+	}
+	return to, res, nil
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches?%s", repo, encodeListOptions(opts))
 	out := []*branch{}
@@ -66,6 +153,13 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	endpoint := fmt.Sprintf("repos/%s/commits?%s", repo, encodeFileCommitListOptions(path, opts))
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/tags?%s", repo, encodeListOptions(opts))
 	out := []*branch{}
@@ -87,6 +181,37 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/compare/%s...%s", repo, base, head)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertComparison(out), res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/compare/%s...%s", repo, base, head)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if out.MergeBaseCommit == nil {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertCommit(out.MergeBaseCommit), res, nil
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	out, res, err := s.Compare(ctx, repo, ancestor, descendant)
+	if err != nil {
+		return false, res, err
+	}
+	return out.Behind == 0, res, nil
+}
+
 type createBranch struct {
 	Ref string `json:"ref"`
 	Sha string `json:"sha"`
@@ -112,7 +237,8 @@ type commit struct {
 			Email string    `json:"email"`
 			Date  time.Time `json:"date"`
 		} `json:"committer"`
-		Message string `json:"message"`
+		Message      string        `json:"message"`
+		Verification *verification `json:"verification"`
 	} `json:"commit"`
 	Author struct {
 		AvatarURL string `json:"avatar_url"`
@@ -125,6 +251,12 @@ type commit struct {
 	Files []*file `json:"files"`
 }
 
+type verification struct {
+	Verified  bool        `json:"verified"`
+	Reason    string      `json:"reason"`
+	Signature null.String `json:"signature"`
+}
+
 type ref struct {
 	Ref    string `json:"ref"`
 	Object struct {
@@ -133,8 +265,47 @@ type ref struct {
 	} `json:"object"`
 }
 
+type tagInput struct {
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+	Object  string `json:"object"`
+	Type    string `json:"type"`
+}
+
+type tagObject struct {
+	Sha     string `json:"sha"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+	Tagger  struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"tagger"`
+	Object struct {
+		Type string `json:"type"`
+		Sha  string `json:"sha"`
+	} `json:"object"`
+}
+
 type compare struct {
-	Files []*file `json:"files"`
+	MergeBaseCommit *commit   `json:"merge_base_commit"`
+	AheadBy         int       `json:"ahead_by"`
+	BehindBy        int       `json:"behind_by"`
+	Commits         []*commit `json:"commits"`
+	Files           []*file   `json:"files"`
+}
+
+func convertComparison(from *compare) *scm.Comparison {
+	to := &scm.Comparison{
+		Ahead:   from.AheadBy,
+		Behind:  from.BehindBy,
+		Commits: convertCommitList(from.Commits),
+		Changes: convertChangeList(from.Files),
+	}
+	if from.MergeBaseCommit != nil {
+		to.MergeBase = from.MergeBaseCommit.Sha
+	}
+	return to
 }
 
 func convertCommitList(from []*commit) []*scm.Commit {
@@ -164,7 +335,31 @@ func convertCommit(from *commit) *scm.Commit {
 			Login:  from.Committer.Login,
 			Avatar: from.Committer.AvatarURL,
 		},
+		Verification: convertVerification(from.Commit.Verification),
+	}
+}
+
+// helper function converts the commit verification. The
+// signature type is derived from the armored signature
+// header because github does not include it in the
+// response.
+func convertVerification(from *verification) *scm.Verification {
+	if from == nil {
+		return nil
+	}
+	to := &scm.Verification{
+		Verified: from.Verified,
+		Reason:   from.Reason,
 	}
+	switch {
+	case strings.HasPrefix(from.Signature.String, "-----BEGIN PGP SIGNATURE-----"):
+		to.Type = "gpg"
+	case strings.HasPrefix(from.Signature.String, "-----BEGIN SSH SIGNATURE-----"):
+		to.Type = "ssh"
+	case strings.HasPrefix(from.Signature.String, "-----BEGIN SIGNED MESSAGE-----"):
+		to.Type = "x509"
+	}
+	return to
 }
 
 func convertBranchList(from []*branch) []*scm.Reference {
@@ -206,3 +401,18 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.Commit.Sha,
 	}
 }
+
+func convertTagObject(from *tagObject) *scm.Tag {
+	return &scm.Tag{
+		Name:    from.Tag,
+		Path:    scm.ExpandRef(from.Tag, "refs/tags"),
+		Message: from.Message,
+		Tagger: scm.Signature{
+			Name:  from.Tagger.Name,
+			Email: from.Tagger.Email,
+			Date:  from.Tagger.Date,
+		},
+		Object: from.Sha,
+		Sha:    from.Object.Sha,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -46,6 +47,42 @@ func TestGitFindCommit(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		MatchHeader("Accept", "application/vnd.github.v3.diff").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.diff")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindCommitDiff(context.Background(), "octocat/hello-world", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	raw, _ = os.ReadFile("testdata/commit.diff")
+	if got.Raw != string(raw) {
+		t.Errorf("Want raw diff to match the response body")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -138,6 +175,245 @@ func TestGitCreateBranch(t *testing.T) {
 	}
 }
 
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/ref/tags/v0.0.1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_annotated_ref.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTagDetails(context.Background(), "octocat/hello-world", "v0.0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_object.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitFindTagDetails_Nested(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/ref/tags/v0.0.2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"ref": "refs/tags/v0.0.2", "object": {"type": "tag", "sha": "5b7b3da03c89f2f244a4a448f3a8d76a9c4cf27e"}}`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/tags/5b7b3da03c89f2f244a4a448f3a8d76a9c4cf27e").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object_nested.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTagDetails(context.Background(), "octocat/hello-world", "v0.0.2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_object_nested.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitFindTagDetails_Lightweight(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/ref/tags/v0.1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.FindTagDetails(context.Background(), "octocat/hello-world", "v0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{
+		Name: "v0.1",
+		Path: "refs/tags/v0.1",
+		Sha:  "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]interface{}{
+			"ref": "refs/tags/v0.1",
+			"sha": "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag.json")
+
+	params := &scm.TagInput{
+		Name: "v0.1",
+		Sha:  "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{
+		Name: "v0.1",
+		Path: "refs/tags/v0.1",
+		Sha:  "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag_Annotated(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/tags").
+		JSON(map[string]interface{}{
+			"tag":     "v0.0.1",
+			"message": "initial version",
+			"object":  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"type":    "commit",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]interface{}{
+			"ref": "refs/tags/v0.0.1",
+			"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_annotated_ref.json")
+
+	params := &scm.TagInput{
+		Name:    "v0.0.1",
+		Sha:     "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Message: "initial version",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_object.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/tags/v0.1").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "octocat/hello-world", "v0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/heads/feature").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "octocat/hello-world", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -173,6 +449,46 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits").
+		MatchParam("path", "README").
+		MatchParam("sha", "master").
+		MatchParam("since", "2012-01-01T00:00:00Z").
+		MatchParam("until", "2013-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{
+		Ref:   "master",
+		Since: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2013, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.ListFileCommits(context.Background(), "octocat/hello-world", "README", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -300,3 +616,110 @@ func TestGitCompareChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/553c2077f0edc3d5dc5d17262f6aa498e69d6f8e...7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.Compare(context.Background(), "octocat/hello-world", "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.MergeBase, "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+	if got, want := got.Ahead, 2; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+	if got, want := len(got.Commits), 2; got != want {
+		t.Errorf("Want %d commits, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/compare.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitFindMergeBase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/master...feature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.FindMergeBase(context.Background(), "octocat/hello-world", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Sha, "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/master...feature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.IsAncestor(context.Background(), "octocat/hello-world", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want master to be an ancestor of feature")
+	}
+}
+
+func TestConvertVerification(t *testing.T) {
+	tests := []struct {
+		signature string
+		want      string
+	}{
+		{"-----BEGIN PGP SIGNATURE-----\n", "gpg"},
+		{"-----BEGIN SSH SIGNATURE-----\n", "ssh"},
+		{"-----BEGIN SIGNED MESSAGE-----\n", "x509"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		from := &verification{Verified: true, Reason: "valid"}
+		from.Signature.String = test.signature
+		from.Signature.Valid = test.signature != ""
+		if got, want := convertVerification(from).Type, test.want; got != want {
+			t.Errorf("Want signature type %q, got %q", want, got)
+		}
+	}
+	if convertVerification(nil) != nil {
+		t.Errorf("Want nil verification when not included in the response")
+	}
+}
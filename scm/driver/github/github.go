@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
 	"strings"
@@ -36,14 +37,17 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGithub
 	client.Linker = &linker{websiteAddress(base)}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &RepositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -79,7 +83,30 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		}
 		req.Body = buf
 	}
+	return c.send(ctx, req, out)
+}
 
+// diff fetches the resource using the diff media type and
+// parses the unified diff.
+func (c *wrapper) diff(ctx context.Context, path string) (*scm.Diff, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.v3.diff"},
+		},
+	}
+	buf := new(bytes.Buffer)
+	res, err := c.send(ctx, req, buf)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := scm.ParseDiff(buf.String())
+	return diff, res, err
+}
+
+// send executes the request and unmarshals the response.
+func (c *wrapper) send(ctx context.Context, req *scm.Request, out interface{}) (*scm.Response, error) {
 	// execute the http request
 	res, err := c.Client.Do(ctx, req)
 	if err != nil {
@@ -116,11 +143,49 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		return res, nil
 	}
 
+	// if raw output is expected, copy to the provided
+	// buffer and exit.
+	if w, ok := out.(io.Writer); ok {
+		io.Copy(w, res.Body)
+		return res, nil
+	}
+
 	// if a json response is expected, parse and return
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// graphql executes the graphql query and unmarshals the
+// query data. The graphql endpoint of GitHub Enterprise is
+// not located under the versioned rest api path.
+func (c *wrapper) graphql(ctx context.Context, in *graphqlInput, out interface{}) (*scm.Response, error) {
+	path := "graphql"
+	if strings.HasSuffix(c.BaseURL.Path, "/v3/") {
+		path = "../graphql"
+	}
+	data := &graphqlResponse{Data: out}
+	res, err := c.do(ctx, "POST", path, in, data)
+	if err != nil {
+		return res, err
+	}
+	if len(data.Errors) != 0 {
+		return res, &Error{Message: data.Errors[0].Message}
+	}
+	return res, nil
+}
+
+type graphqlInput struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
 // Error represents a Github error.
 type Error struct {
 	Message string `json:"message"`
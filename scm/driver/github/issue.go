@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -46,15 +47,20 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues", repo)
-	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
-	}
+	in := convertFromIssueInput(input)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := convertFromIssueInput(input)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/comments", repo, number)
 	in := &issueCommentInput{
@@ -78,6 +84,40 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", repo, number)
+	in := &issueLabelsInput{Labels: labels}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels/%s", repo, number, url.PathEscape(label))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := &issueAssigneesInput{Assignees: append([]string{}, logins...)}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	data := map[string]interface{}{"milestone": nil}
+	if milestone != 0 {
+		data["milestone"] = milestone
+	}
+	return s.client.do(ctx, "PATCH", path, &data, nil)
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/lock", repo, number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -111,8 +151,19 @@ type issue struct {
 }
 
 type issueInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Title     string   `json:"title,omitempty"`
+	Body      string   `json:"body,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone int      `json:"milestone,omitempty"`
+}
+
+type issueLabelsInput struct {
+	Labels []string `json:"labels"`
+}
+
+type issueAssigneesInput struct {
+	Assignees []string `json:"assignees"`
 }
 
 type issueComment struct {
@@ -132,6 +183,18 @@ type issueCommentInput struct {
 	Body string `json:"body"`
 }
 
+// helper function to convert from the common issue input
+// to the github issue input.
+func convertFromIssueInput(from *scm.IssueInput) *issueInput {
+	return &issueInput{
+		Title:     from.Title,
+		Body:      from.Body,
+		Labels:    from.Labels,
+		Assignees: from.Assignees,
+		Milestone: from.Milestone,
+	}
+}
+
 // helper function to convert from the gogs issue list to
 // the common issue structure.
 func convertIssueList(from []*issue) []*scm.Issue {
@@ -180,6 +180,49 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Found a bug",
+			"labels":    []string{"bug"},
+			"assignees": []string{"octocat"},
+			"milestone": 1,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Labels:    []string{"bug"},
+		Assignees: []string{"octocat"},
+		Milestone: 1,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "octocat/hello-world", 1, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := os.ReadFile("testdata/issue.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -295,3 +338,112 @@ func TestIssueUnlock(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueAddLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []string{"bug", "enhancement"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"name": "bug"}, {"name": "enhancement"}]`)
+
+	client := NewDefault()
+	res, err := client.Issues.AddLabels(context.Background(), "octocat/hello-world", 1, []string{"bug", "enhancement"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1/labels/help wanted").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[]`)
+
+	client := NewDefault()
+	res, err := client.Issues.RemoveLabel(context.Background(), "octocat/hello-world", 1, "help wanted")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{"assignees": []string{}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.SetAssignees(context.Background(), "octocat/hello-world", 1, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueSetMilestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]interface{}{"milestone": 2}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.SetMilestone(context.Background(), "octocat/hello-world", 1, 2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
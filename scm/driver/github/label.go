@@ -0,0 +1,118 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels?%s", repo, encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelUpdateInput{
+		NewName:     input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddToIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabels(ctx, repo, number, []string{name})
+}
+
+func (s *labelService) RemoveFromIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, name)
+}
+
+// AddToPullRequest adds the label to the pull request. GitHub
+// manages pull request labels through the issues api.
+func (s *labelService) AddToPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.AddToIssue(ctx, repo, number, name)
+}
+
+// RemoveFromPullRequest removes the label from the pull request.
+// GitHub manages pull request labels through the issues api.
+func (s *labelService) RemoveFromPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.RemoveFromIssue(ctx, repo, number, name)
+}
+
+type label struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+type labelInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type labelUpdateInput struct {
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Color:       from.Color,
+		Description: from.Description,
+	}
+}
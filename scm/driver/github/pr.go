@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -24,6 +25,11 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	return s.client.diff(ctx, path)
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls?%s", repo, encodePullRequestListOptions(opts))
 	out := []*pr{}
@@ -51,6 +57,32 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input.Method == scm.MergeMethodFastForward {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/merge", repo, number)
+	in := &mergeInput{
+		CommitTitle:   input.CommitTitle,
+		CommitMessage: input.CommitMessage,
+		Sha:           input.Sha,
+		MergeMethod:   convertFromMergeMethod(input.Method),
+	}
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	if err != nil || !input.DeleteSourceBranch {
+		return res, err
+	}
+	// github does not delete the source branch as part of
+	// the merge, so the branch reference is deleted once the
+	// pull request is merged. Branches in forks are skipped.
+	pull, res, err := s.Find(ctx, repo, number)
+	if err != nil || !strings.EqualFold(pull.Fork, repo) {
+		return res, err
+	}
+	path = fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, pull.Source)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -135,6 +167,13 @@ type prInput struct {
 	MaintainerCanModify bool   `json:"maintainer_can_modify,omitempty"`
 }
 
+type mergeInput struct {
+	CommitTitle   string `json:"commit_title,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+	Sha           string `json:"sha,omitempty"`
+	MergeMethod   string `json:"merge_method,omitempty"`
+}
+
 type file struct {
 	BlobID           string `json:"sha"`
 	Filename         string `json:"filename"`
@@ -194,6 +233,19 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 	}
 }
 
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodMerge:
+		return "merge"
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	default:
+		return ""
+	}
+}
+
 func convertChangeList(from []*file) []*scm.Change {
 	to := []*scm.Change{}
 	for _, v := range from {
@@ -46,6 +46,37 @@ func TestPullFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		MatchHeader("Accept", "application/vnd.github.v3.diff").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.diff")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.FindDiff(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -133,6 +164,63 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		JSON(map[string]string{
+			"commit_title": "Amazing new feature (#1347)",
+			"sha":          "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"merge_method": "squash",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/heads/new-topic").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Amazing new feature (#1347)",
+		Sha:                "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.MergeWithOptions(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect source branch to be deleted")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMergeWithOptions_FastForward(t *testing.T) {
+	client := NewDefault()
+	input := &scm.MergeInput{Method: scm.MergeMethodFastForward}
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "octocat/hello-world", 1347, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
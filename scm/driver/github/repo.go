@@ -6,8 +6,10 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -43,6 +45,27 @@ type repository struct {
 	LanguagesURL string `json:"languages_url"`
 }
 
+type hookDelivery struct {
+	ID          int64     `json:"id"`
+	GUID        string    `json:"guid"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	Redelivery  bool      `json:"redelivery"`
+	Duration    float64   `json:"duration"`
+	Status      string    `json:"status"`
+	StatusCode  int       `json:"status_code"`
+	Event       string    `json:"event"`
+	Action      string    `json:"action"`
+	URL         string    `json:"url"`
+	Request     *struct {
+		Headers map[string]string `json:"headers"`
+		Payload json.RawMessage   `json:"payload"`
+	} `json:"request"`
+	Response *struct {
+		Headers map[string]string `json:"headers"`
+		Payload string            `json:"payload"`
+	} `json:"response"`
+}
+
 type searchRepositoryList struct {
 	Repositories []*repository `json:"items"`
 }
@@ -60,6 +83,25 @@ type hook struct {
 	} `json:"config"`
 }
 
+type repositoryInput struct {
+	Name              string `json:"name,omitempty"`
+	Description       string `json:"description,omitempty"`
+	DefaultBranch     string `json:"default_branch,omitempty"`
+	Private           bool   `json:"private,omitempty"`
+	Visibility        string `json:"visibility,omitempty"`
+	AutoInit          bool   `json:"auto_init,omitempty"`
+	GitignoreTemplate string `json:"gitignore_template,omitempty"`
+	Archived          bool   `json:"archived,omitempty"`
+}
+
+type repositoryArchiveInput struct {
+	Archived bool `json:"archived"`
+}
+
+type forkInput struct {
+	Organization string `json:"organization,omitempty"`
+}
+
 type repositoryList struct {
 	TotalCount   int           `json:"total_count"`
 	Repositories []*repository `json:"repositories"`
@@ -152,19 +194,8 @@ func (s *RepositoryService) ListStatus(ctx context.Context, repo, ref string, op
 // CreateHook creates a new repository webhook.
 func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks", repo)
-	in := new(hook)
-	in.Active = true
+	in := convertFromHookInput(input)
 	in.Name = "web"
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = input.Target
-	if input.SkipVerify {
-		in.Config.InsecureSSL = "1"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -202,18 +233,7 @@ func (s *RepositoryService) CreateDeployStatus(ctx context.Context, repo string,
 // UpdateHook updates a repository webhook.
 func (s *RepositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks/%s", repo, id)
-	in := new(hook)
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = input.Target
-	if input.SkipVerify {
-		in.Config.InsecureSSL = "1"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
 	return convertHook(out), res, err
@@ -225,6 +245,89 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries returns a list of recent webhook deliveries.
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries?%s", repo, id, encodeListOptions(opts))
+	out := []*hookDelivery{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookDeliveryList(id, out), res, err
+}
+
+// FindHookDelivery returns a webhook delivery, including the
+// request and response.
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s", repo, id, delivery)
+	out := new(hookDelivery)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHookDelivery(id, out), res, err
+}
+
+// RedeliverHook redelivers a webhook delivery.
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s/attempts", repo, id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// Create creates a new repository.
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// github does not accept the default branch on create;
+	// it is always taken from the user or organization
+	// settings.
+	if input.Branch != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.Namespace)
+	}
+	in := convertFromRepositoryInput(input)
+	in.AutoInit = input.Readme
+	in.GitignoreTemplate = input.Gitignore
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Fork forks the repository into the namespace.
+func (s *RepositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/forks", repo)
+	in := &forkInput{Organization: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates the repository settings.
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := convertFromRepositoryInput(input)
+	in.DefaultBranch = input.Branch
+	in.Archived = input.Archived
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Archive archives the repository.
+func (s *RepositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositoryArchiveInput{Archived: true}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+// Unarchive unarchives the repository.
+func (s *RepositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositoryArchiveInput{Archived: false}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+// Delete deletes the repository.
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func (s *RepositoryService) convertRepositoryList(ctx context.Context, from []*repository, additional scm.AdditionalInfo) []*scm.Repository {
@@ -297,6 +400,67 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertHookDeliveryList(hookID string, from []*hookDelivery) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookDelivery(hookID, v))
+	}
+	return to
+}
+
+func convertHookDelivery(hookID string, from *hookDelivery) *scm.HookDelivery {
+	to := &scm.HookDelivery{
+		ID:         strconv.FormatInt(from.ID, 10),
+		HookID:     hookID,
+		GUID:       from.GUID,
+		Event:      from.Event,
+		Action:     from.Action,
+		Target:     from.URL,
+		Status:     from.StatusCode,
+		Success:    from.StatusCode >= 200 && from.StatusCode < 300,
+		Redelivery: from.Redelivery,
+		Duration:   time.Duration(from.Duration * float64(time.Second)),
+		Created:    from.DeliveredAt,
+	}
+	if from.Request != nil {
+		to.Request = scm.HookDeliveryMessage{
+			Header: convertHookDeliveryHeader(from.Request.Headers),
+			Body:   string(from.Request.Payload),
+		}
+	}
+	if from.Response != nil {
+		to.Response = scm.HookDeliveryMessage{
+			Header: convertHookDeliveryHeader(from.Response.Headers),
+			Body:   from.Response.Payload,
+		}
+	}
+	return to
+}
+
+func convertHookDeliveryHeader(from map[string]string) http.Header {
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
+
+func convertFromHookInput(from *scm.HookInput) *hook {
+	to := new(hook)
+	to.Active = true
+	to.Config.Secret = from.Secret
+	to.Config.ContentType = "json"
+	to.Config.URL = from.Target
+	if from.SkipVerify {
+		to.Config.InsecureSSL = "1"
+	}
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -324,6 +488,28 @@ func convertFromHookEvents(from scm.HookEvents) []string {
 	return events
 }
 
+func convertFromRepositoryInput(from *scm.RepositoryInput) *repositoryInput {
+	return &repositoryInput{
+		Name:        from.Name,
+		Description: from.Description,
+		Private:     from.Visibility == scm.VisibilityPrivate,
+		Visibility:  convertFromVisibility(from.Visibility),
+	}
+}
+
+func convertFromVisibility(from scm.Visibility) string {
+	switch from {
+	case scm.VisibilityPublic:
+		return "public"
+	case scm.VisibilityPrivate:
+		return "private"
+	case scm.VisibilityInternal:
+		return "internal"
+	default:
+		return ""
+	}
+}
+
 func convertVisibility(from string) scm.Visibility {
 	switch from {
 	case "public":
@@ -375,7 +561,7 @@ func convertStatus(from *status) *scm.Status {
 func convertDeployStatus(from *deployStatus) *scm.DeployStatus {
 	return &scm.DeployStatus{
 		Number:         from.ID,
-		State:          convertState(from.State),
+		State:          convertDeployState(from.State),
 		Desc:           from.Description,
 		Target:         from.TargetURL,
 		Environment:    from.Environment,
@@ -7,9 +7,11 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
 )
 
 type reviewService struct {
@@ -32,11 +34,10 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/comments", repo, number)
-	in := &reviewInput{
-		Body:     input.Body,
-		Path:     input.Path,
-		Position: input.Line,
-		CommitID: input.Sha,
+	in := convertFromReviewInput(input)
+	if reply := replyTo(input); reply != 0 {
+		path = fmt.Sprintf("repos/%s/pulls/%d/comments/%d/replies", repo, number, reply)
+		in = &reviewInput{Body: input.Body}
 	}
 	out := new(review)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -48,12 +49,142 @@ func (s *reviewService) Delete(ctx context.Context, repo string, number, id int)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Resolve resolves the review thread. The thread is identified
+// by the first comment id, or by the graphql thread node id.
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolve(ctx, repo, number, thread, "resolveReviewThread")
+}
+
+// Unresolve unresolves the review thread. The thread is identified
+// by the first comment id, or by the graphql thread node id.
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolve(ctx, repo, number, thread, "unresolveReviewThread")
+}
+
+// helper function resolves or unresolves the review thread.
+// The rest api does not support threads, so the graphql api
+// is used instead.
+func (s *reviewService) resolve(ctx context.Context, repo string, number int, thread, mutation string) (*scm.Response, error) {
+	id := thread
+	if _, err := strconv.Atoi(thread); err == nil {
+		var res *scm.Response
+		id, res, err = s.findThread(ctx, repo, number, thread)
+		if err != nil {
+			return res, err
+		}
+	}
+	in := &graphqlInput{
+		Query:     fmt.Sprintf("mutation($id: ID!) { %s(input: {threadId: $id}) { thread { id } } }", mutation),
+		Variables: map[string]interface{}{"id": id},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// helper function returns the graphql node id of the review
+// thread that starts with the comment id.
+func (s *reviewService) findThread(ctx context.Context, repo string, number int, comment string) (string, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	in := &graphqlInput{
+		Query: `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100) {
+        nodes { id comments(first: 1) { nodes { databaseId } } }
+      }
+    }
+  }
+}`,
+		Variables: map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"number": number,
+		},
+	}
+	out := new(reviewThreads)
+	res, err := s.client.graphql(ctx, in, out)
+	if err != nil {
+		return "", res, err
+	}
+	for _, v := range out.Repository.PullRequest.ReviewThreads.Nodes {
+		for _, c := range v.Comments.Nodes {
+			if strconv.Itoa(c.DatabaseID) == comment {
+				return v.ID, res, nil
+			}
+		}
+	}
+	return "", res, scm.ErrNotFound
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews", repo, number)
+	in := &reviewSubmitInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Event:    convertFromReviewState(input.State),
+	}
+	for _, v := range input.Comments {
+		in.Comments = append(in.Comments, convertFromReviewInput(v))
+	}
+	out := new(reviewSubmission)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewSubmission(out), res, err
+}
+
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*reviewSubmission{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewSubmissionList(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &reviewDismissInput{
+		Message: message,
+		Event:   "DISMISS",
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// ListReviewers returns the requested reviewers and the
+// authors of submitted reviews. The latest review of each
+// author determines the reviewer state.
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	submissions, res, err := s.ListSubmissions(ctx, repo, number, opts)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	out := new(requestedReviewers)
+	if res, err := s.client.do(ctx, "GET", path, nil, out); err != nil {
+		return nil, res, err
+	}
+	return convertReviewerList(submissions, out.Users), res, nil
+}
+
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{Reviewers: logins}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &reviewersInput{Reviewers: logins}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
 type review struct {
-	ID       int    `json:"id"`
-	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
-	Path     string `json:"path"`
-	User     struct {
+	ID          int         `json:"id"`
+	CommitID    string      `json:"commit_id"`
+	Position    int         `json:"position"`
+	Line        null.Int    `json:"line"`
+	StartLine   null.Int    `json:"start_line"`
+	Side        null.String `json:"side"`
+	InReplyToID int         `json:"in_reply_to_id"`
+	Path        string      `json:"path"`
+	HTMLURL     string      `json:"html_url"`
+	User        struct {
 		ID        int    `json:"id"`
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
@@ -64,10 +195,61 @@ type review struct {
 }
 
 type reviewInput struct {
-	Body     string `json:"body"`
-	Path     string `json:"path"`
-	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
+	Body      string `json:"body"`
+	Path      string `json:"path,omitempty"`
+	CommitID  string `json:"commit_id,omitempty"`
+	Position  int    `json:"position,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+}
+
+type reviewSubmission struct {
+	ID          int       `json:"id"`
+	Body        string    `json:"body"`
+	State       string    `json:"state"`
+	CommitID    string    `json:"commit_id"`
+	HTMLURL     string    `json:"html_url"`
+	User        user      `json:"user"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type reviewSubmitInput struct {
+	Body     string         `json:"body,omitempty"`
+	CommitID string         `json:"commit_id,omitempty"`
+	Event    string         `json:"event"`
+	Comments []*reviewInput `json:"comments,omitempty"`
+}
+
+type reviewThreads struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				Nodes []struct {
+					ID       string `json:"id"`
+					Comments struct {
+						Nodes []struct {
+							DatabaseID int `json:"databaseId"`
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"nodes"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+type reviewDismissInput struct {
+	Message string `json:"message"`
+	Event   string `json:"event"`
+}
+
+type reviewersInput struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+type requestedReviewers struct {
+	Users []*user `json:"users"`
 }
 
 func convertReviewList(from []*review) []*scm.Review {
@@ -79,12 +261,18 @@ func convertReviewList(from []*review) []*scm.Review {
 }
 
 func convertReview(from *review) *scm.Review {
-	return &scm.Review{
-		ID:   from.ID,
-		Body: from.Body,
-		Path: from.Path,
-		Line: from.Position,
-		Sha:  from.CommitID,
+	to := &scm.Review{
+		ID:        from.ID,
+		Body:      from.Body,
+		Path:      from.Path,
+		Line:      from.Position,
+		StartLine: int(from.StartLine.Int64),
+		EndLine:   int(from.Line.Int64),
+		Side:      convertSide(from.Side.String),
+		Sha:       from.CommitID,
+		Thread:    strconv.Itoa(from.ID),
+		InReplyTo: from.InReplyToID,
+		Link:      from.HTMLURL,
 		Author: scm.User{
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
@@ -92,4 +280,133 @@ func convertReview(from *review) *scm.Review {
 		Created: from.CreatedAt,
 		Updated: from.UpdatedAt,
 	}
+	// the thread is identified by the first comment,
+	// which is the comment that all replies reply to.
+	if from.InReplyToID != 0 {
+		to.Thread = strconv.Itoa(from.InReplyToID)
+	}
+	return to
+}
+
+// helper function converts the review input. The comment is
+// placed by the line number in the file when the end line is
+// set, and otherwise by the position in the diff.
+func convertFromReviewInput(from *scm.ReviewInput) *reviewInput {
+	to := &reviewInput{
+		Body:     from.Body,
+		Path:     from.Path,
+		CommitID: from.Sha,
+	}
+	if from.EndLine == 0 {
+		to.Position = from.Line
+		return to
+	}
+	to.Line = from.EndLine
+	to.Side = convertFromSide(from.Side)
+	if from.StartLine != 0 && from.StartLine != from.EndLine {
+		to.StartLine = from.StartLine
+		to.StartSide = to.Side
+	}
+	return to
+}
+
+// helper function returns the comment id a reply is added
+// to. The thread is identified by the first comment id.
+func replyTo(from *scm.ReviewInput) int {
+	if from.InReplyTo != 0 {
+		return from.InReplyTo
+	}
+	id, _ := strconv.Atoi(from.Thread)
+	return id
+}
+
+func convertSide(from string) scm.DiffSide {
+	if from == "LEFT" {
+		return scm.DiffSideBase
+	}
+	return scm.DiffSideHead
+}
+
+func convertFromSide(from scm.DiffSide) string {
+	if from == scm.DiffSideBase {
+		return "LEFT"
+	}
+	return "RIGHT"
+}
+
+func convertReviewSubmissionList(from []*reviewSubmission) []*scm.ReviewSubmission {
+	to := []*scm.ReviewSubmission{}
+	for _, v := range from {
+		to = append(to, convertReviewSubmission(v))
+	}
+	return to
+}
+
+func convertReviewSubmission(from *reviewSubmission) *scm.ReviewSubmission {
+	return &scm.ReviewSubmission{
+		ID:        from.ID,
+		Body:      from.Body,
+		State:     convertReviewState(from.State),
+		Sha:       from.CommitID,
+		Link:      from.HTMLURL,
+		Author:    *convertUser(&from.User),
+		Submitted: from.SubmittedAt,
+	}
+}
+
+// helper function builds the reviewer list from the submitted
+// reviews, in chronological order, and the requested reviewers.
+// A comment does not change the state of a previous review,
+// and a requested reviewer is pending until the next review.
+func convertReviewerList(submissions []*scm.ReviewSubmission, requested []*user) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	index := map[string]*scm.Reviewer{}
+	for _, v := range submissions {
+		reviewer, ok := index[v.Author.Login]
+		if !ok {
+			reviewer = &scm.Reviewer{User: v.Author, State: v.State}
+			index[v.Author.Login] = reviewer
+			to = append(to, reviewer)
+		} else if v.State != scm.ReviewStateCommented {
+			reviewer.State = v.State
+		}
+	}
+	for _, v := range requested {
+		reviewer, ok := index[v.Login]
+		if !ok {
+			reviewer = &scm.Reviewer{User: *convertUser(v)}
+			index[v.Login] = reviewer
+			to = append(to, reviewer)
+		}
+		reviewer.State = scm.ReviewStatePending
+	}
+	return to
+}
+
+func convertReviewState(from string) scm.ReviewState {
+	switch from {
+	case "PENDING":
+		return scm.ReviewStatePending
+	case "COMMENTED":
+		return scm.ReviewStateCommented
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "CHANGES_REQUESTED":
+		return scm.ReviewStateChangesRequested
+	case "DISMISSED":
+		return scm.ReviewStateDismissed
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+func convertFromReviewState(from scm.ReviewState) string {
+	switch from {
+	case scm.ReviewStateApproved:
+		return "APPROVE"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	default:
+		return "COMMENT"
+	}
 }
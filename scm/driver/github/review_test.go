@@ -84,6 +84,12 @@ func TestReviewCreate(t *testing.T) {
 
 	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/pulls/1/comments").
+		JSON(map[string]interface{}{
+			"body":      "what?",
+			"path":      "file1.txt",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"position":  1,
+		}).
 		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -116,6 +122,50 @@ func TestReviewCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestReviewCreateLines(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments").
+		JSON(map[string]interface{}{
+			"body":       "what?",
+			"path":       "file1.txt",
+			"commit_id":  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"line":       5,
+			"side":       "LEFT",
+			"start_line": 2,
+			"start_side": "LEFT",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id": 10, "body": "what?", "path": "file1.txt", "position": 4,
+			"line": 5, "start_line": 2, "side": "LEFT", "start_side": "LEFT"}`)
+
+	input := &scm.ReviewInput{
+		Body:      "what?",
+		Path:      "file1.txt",
+		Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		StartLine: 2,
+		EndLine:   5,
+		Side:      scm.DiffSideBase,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Create(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.StartLine != input.StartLine || got.EndLine != input.EndLine || got.Side != input.Side {
+		t.Errorf("Want lines %d-%d on side %s, got %d-%d on side %s",
+			input.StartLine, input.EndLine, input.Side, got.StartLine, got.EndLine, got.Side)
+	}
+	if got.Line != 4 {
+		t.Errorf("Want review position 4, got %d", got.Line)
+	}
+}
+
 func TestReviewDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -135,3 +185,268 @@ func TestReviewDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/12/reviews").
+		JSON(map[string]interface{}{
+			"body":      "Looks good to me.",
+			"commit_id": "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+			"event":     "APPROVE",
+			"comments": []map[string]interface{}{
+				{"body": "nit", "path": "file1.txt", "line": 3, "side": "RIGHT", "start_line": 1, "start_side": "RIGHT"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review.json")
+
+	input := &scm.ReviewSubmitInput{
+		Body:  "Looks good to me.",
+		State: scm.ReviewStateApproved,
+		Sha:   "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+		Comments: []*scm.ReviewInput{
+			{Body: "nit", Path: "file1.txt", StartLine: 1, EndLine: 3},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Submit(context.Background(), "octocat/hello-world", 12, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewSubmission)
+	raw, _ := os.ReadFile("testdata/pr_review.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListSubmissions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/12/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/pr_reviews.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListSubmissions(context.Background(), "octocat/hello-world", 12, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSubmission{}
+	raw, _ := os.ReadFile("testdata/pr_reviews.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/12/reviews/80/dismissals").
+		JSON(map[string]interface{}{"message": "stale", "event": "DISMISS"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.Dismiss(context.Background(), "octocat/hello-world", 12, 80, "stale")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/12/reviews").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_reviews.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/12/requested_reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/requested_reviewers.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListReviewers(context.Background(), "octocat/hello-world", 12, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/reviewers.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/12/requested_reviewers").
+		JSON(map[string]interface{}{"reviewers": []string{"hubot", "monalisa"}}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.RequestReviewers(context.Background(), "octocat/hello-world", 12, []string{"hubot", "monalisa"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/pulls/12/requested_reviewers").
+		JSON(map[string]interface{}{"reviewers": []string{"hubot"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.RemoveReviewers(context.Background(), "octocat/hello-world", 12, []string{"hubot"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewCreateReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments/8/replies").
+		JSON(map[string]interface{}{"body": "Great stuff"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_comment.json")
+
+	input := &scm.ReviewInput{
+		Body:   "Great stuff",
+		Thread: "8",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Create(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Thread != "8" {
+		t.Errorf("Want review thread 8, got %s", got.Thread)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("reviewThreads").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data": {"repository": {"pullRequest": {"reviewThreads": {"nodes": [
+			{"id": "PRRT_kwDOAAABc84", "comments": {"nodes": [{"databaseId": 7}]}},
+			{"id": "PRRT_kwDOAAABc85", "comments": {"nodes": [{"databaseId": 8}]}}
+		]}}}}}`)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query":     "mutation($id: ID!) { resolveReviewThread(input: {threadId: $id}) { thread { id } } }",
+			"variables": map[string]interface{}{"id": "PRRT_kwDOAAABc85"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data": {"resolveReviewThread": {"thread": {"id": "PRRT_kwDOAAABc85"}}}}`)
+
+	client := NewDefault()
+	res, err := client.Reviews.Resolve(context.Background(), "octocat/hello-world", 1, "8")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review thread to be resolved")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewUnresolve_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data": null, "errors": [{"message": "Could not resolve to a node with the global id of 'PRRT_unknown'"}]}`)
+
+	client := NewDefault()
+	_, err := client.Reviews.Unresolve(context.Background(), "octocat/hello-world", 1, "PRRT_unknown")
+	if err == nil {
+		t.Errorf("Expect graphql error")
+	}
+}
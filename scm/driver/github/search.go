@@ -0,0 +1,112 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	// the text-match media type is required to include
+	// the matching fragments in the response.
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("search/code?%s", encodeSearchOptions(opts)),
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.v3.text-match+json"},
+		},
+	}
+	out := new(codeSearchResults)
+	res, err := s.client.send(ctx, req, out)
+	return convertCodeResultList(out.Items), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("search/repositories?%s", encodeSearchOptions(opts))
+	out := new(searchRepositoryList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSearchRepositoryList(out.Repositories), res, err
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.SearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("search/issues?%s", encodeSearchOptions(opts))
+	out := new(issueSearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueList(out.Items), res, err
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("search/commits?%s", encodeSearchOptions(opts))
+	out := new(commitSearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCommitList(out.Items), res, err
+}
+
+type codeSearchResults struct {
+	Items []*codeSearchResult `json:"items"`
+}
+
+type codeSearchResult struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Sha        string `json:"sha"`
+	HTMLURL    string `json:"html_url"`
+	Repository struct {
+		ID       int    `json:"id"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	TextMatches []struct {
+		Fragment string `json:"fragment"`
+	} `json:"text_matches"`
+}
+
+type issueSearchResults struct {
+	Items []*issue `json:"items"`
+}
+
+type commitSearchResults struct {
+	Items []*commit `json:"items"`
+}
+
+func convertCodeResultList(from []*codeSearchResult) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+// helper function converts the code search result. The
+// text matches do not include line numbers.
+func convertCodeResult(from *codeSearchResult) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Repo:   from.Repository.FullName,
+		RepoID: strconv.Itoa(from.Repository.ID),
+		Path:   from.Path,
+		Link:   from.HTMLURL,
+	}
+	for _, v := range from.TextMatches {
+		to.Fragments = append(to.Fragments, &scm.CodeFragment{
+			Content: v.Fragment,
+		})
+	}
+	return to
+}
+
+func convertSearchRepositoryList(from []*repository) []*scm.Repository {
+	to := []*scm.Repository{}
+	for _, v := range from {
+		to = append(to, convertRepository(v))
+	}
+	return to
+}
@@ -0,0 +1,143 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/code").
+		MatchHeader("Accept", "application/vnd.github.v3.text-match\\+json").
+		MatchParam("q", "github.com/pkg/errors user:octocat").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_code.json")
+
+	opts := scm.SearchOptions{
+		Query:     "github.com/pkg/errors",
+		Namespace: "octocat",
+		Page:      1,
+		Size:      30,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := os.ReadFile("testdata/search_code.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/repositories").
+		MatchParam("q", "testRepo in:name").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repos_filter.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Repositories(context.Background(), scm.SearchOptions{Query: "testRepo in:name"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := os.ReadFile("testdata/repos_filter.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/issues").
+		MatchParam("q", "is:open repo:octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_issues.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Issues(context.Background(), scm.SearchOptions{Query: "is:open", Repo: "octocat/hello-world"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := os.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/commits").
+		MatchParam("q", "fix repo:octocat/hello-world").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_commits.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Commits(context.Background(), scm.SearchOptions{Query: "fix", Repo: "octocat/hello-world"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
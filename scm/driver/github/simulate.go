@@ -0,0 +1,253 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/hmac"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that github sends, which can be used to test webhook
+// handlers without a connection to github. The payload is
+// signed if the secret is not empty. ErrNotSupported is
+// returned for other hook types and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, payload = "push", simulatePushHook(v)
+	case *scm.PullRequestHook:
+		action, ok := simulateAction(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		event, payload = "pull_request", simulatePullRequestHook(v, action)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GitHub-Hookshot")
+	req.Header.Set("X-GitHub-Event", event)
+	if guid := hook.Meta().GUID; guid != "" {
+		req.Header.Set("X-GitHub-Delivery", guid)
+	}
+	if secret != "" {
+		req.Header.Set("X-Hub-Signature", "sha1="+hmac.Sign(sha1.New, data, []byte(secret)))
+		req.Header.Set("X-Hub-Signature-256", hmac.SignPrefix(data, []byte(secret)))
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) map[string]interface{} {
+	commits := []interface{}{}
+	for _, c := range src.Commits {
+		commits = append(commits, simulateCommit(&c))
+	}
+	var head interface{}
+	if src.Commit.Sha != "" {
+		head = simulateCommit(&src.Commit)
+	}
+	var baseRef interface{}
+	if src.BaseRef != "" {
+		baseRef = src.BaseRef
+	}
+	return map[string]interface{}{
+		"ref":         src.Ref,
+		"before":      src.Before,
+		"after":       src.After,
+		"created":     isZeroSha(src.Before),
+		"deleted":     isZeroSha(src.After),
+		"forced":      false,
+		"base_ref":    baseRef,
+		"compare":     src.Commit.Link,
+		"commits":     commits,
+		"head_commit": head,
+		"repository":  simulateRepository(&src.Repo),
+		"pusher": map[string]interface{}{
+			"name":  src.Sender.Login,
+			"email": src.Sender.Email,
+		},
+		"sender": simulateUser(&src.Sender),
+	}
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, action string) map[string]interface{} {
+	pr := &src.PullRequest
+	state := "open"
+	if pr.Closed || pr.Merged || src.Action == scm.ActionClose || src.Action == scm.ActionMerge {
+		state = "closed"
+	}
+	merged := pr.Merged || src.Action == scm.ActionMerge
+	var mergedAt interface{}
+	if merged {
+		mergedAt = pr.Updated
+	}
+	fork := pr.Fork
+	if fork == "" {
+		fork = scm.Join(src.Repo.Namespace, src.Repo.Name)
+	}
+	labels := []interface{}{}
+	for _, label := range pr.Labels {
+		labels = append(labels, map[string]interface{}{
+			"name":        label.Name,
+			"color":       label.Color,
+			"description": label.Description,
+		})
+	}
+	return map[string]interface{}{
+		"action": action,
+		"number": pr.Number,
+		"pull_request": map[string]interface{}{
+			"number":     pr.Number,
+			"state":      state,
+			"title":      pr.Title,
+			"body":       pr.Body,
+			"html_url":   pr.Link,
+			"diff_url":   pr.Diff,
+			"user":       simulateUser(&pr.Author),
+			"merged":     merged,
+			"merged_at":  mergedAt,
+			"created_at": pr.Created,
+			"updated_at": pr.Updated,
+			"labels":     labels,
+			"head": map[string]interface{}{
+				"ref": pr.Source,
+				"sha": pr.Sha,
+				"repo": map[string]interface{}{
+					"full_name": fork,
+				},
+			},
+			"base": map[string]interface{}{
+				"ref":  pr.Target,
+				"sha":  pr.Base.Sha,
+				"repo": simulateRepository(&src.Repo),
+			},
+		},
+		"repository": simulateRepository(&src.Repo),
+		"sender":     simulateUser(&src.Sender),
+	}
+}
+
+func simulateCommit(src *scm.Commit) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        src.Sha,
+		"distinct":  true,
+		"message":   src.Message,
+		"timestamp": src.Author.Date,
+		"url":       src.Link,
+		"author": map[string]interface{}{
+			"name":     src.Author.Name,
+			"email":    src.Author.Email,
+			"username": src.Author.Login,
+		},
+		"committer": map[string]interface{}{
+			"name":     src.Committer.Name,
+			"email":    src.Committer.Email,
+			"username": src.Committer.Login,
+		},
+		"added":    []string{},
+		"removed":  []string{},
+		"modified": []string{},
+	}
+}
+
+func simulateRepository(src *scm.Repository) map[string]interface{} {
+	id, _ := strconv.ParseInt(src.ID, 10, 64)
+	return map[string]interface{}{
+		"id":        id,
+		"name":      src.Name,
+		"full_name": scm.Join(src.Namespace, src.Name),
+		"owner": map[string]interface{}{
+			"login": src.Namespace,
+		},
+		"private":        src.Private,
+		"visibility":     simulateVisibility(src),
+		"archived":       src.Archived,
+		"html_url":       src.Link,
+		"clone_url":      src.Clone,
+		"ssh_url":        src.CloneSSH,
+		"default_branch": src.Branch,
+		"description":    src.Description,
+	}
+}
+
+func simulateUser(src *scm.User) map[string]interface{} {
+	id, _ := strconv.ParseInt(src.ID, 10, 64)
+	return map[string]interface{}{
+		"id":         id,
+		"login":      src.Login,
+		"name":       src.Name,
+		"email":      src.Email,
+		"avatar_url": src.Avatar,
+		"type":       "User",
+	}
+}
+
+func simulateVisibility(src *scm.Repository) string {
+	switch {
+	case src.Visibility != scm.VisibilityUndefined:
+		return src.Visibility.String()
+	case src.Private:
+		return "private"
+	default:
+		return "public"
+	}
+}
+
+// helper function returns the native pull request action.
+func simulateAction(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "opened", true
+	case scm.ActionClose, scm.ActionMerge:
+		return "closed", true
+	case scm.ActionReopen:
+		return "reopened", true
+	case scm.ActionSync:
+		return "synchronize", true
+	case scm.ActionUpdate, scm.ActionEdit:
+		return "edited", true
+	case scm.ActionLabel:
+		return "labeled", true
+	case scm.ActionUnlabel:
+		return "unlabeled", true
+	case scm.ActionReviewReady:
+		return "ready_for_review", true
+	case scm.ActionAssign:
+		return "assigned", true
+	case scm.ActionUnassign:
+		return "unassigned", true
+	default:
+		return "", false
+	}
+}
+
+// helper function returns true if the sha is the zero
+// sha, which github sends for created and deleted refs.
+func isZeroSha(sha string) bool {
+	return sha == "0000000000000000000000000000000000000000"
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:         "1296269",
+	Namespace:  "octocat",
+	Name:       "hello-world",
+	Branch:     "master",
+	Visibility: scm.VisibilityPublic,
+	Clone:      "https://github.com/octocat/hello-world.git",
+	CloneSSH:   "git@github.com:octocat/hello-world.git",
+	Link:       "https://github.com/octocat/hello-world",
+}
+
+var simulateSender = scm.User{
+	Login:  "octocat",
+	Name:   "The Octocat",
+	Email:  "octocat@github.com",
+	Avatar: "https://github.com/images/error/octocat_happy.gif",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	signature := scm.Signature{
+		Name:  "The Octocat",
+		Email: "octocat@github.com",
+		Login: "octocat",
+		Date:  date,
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e",
+			After:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Commit: scm.Commit{
+				Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				Message:   "Update README.md",
+				Link:      "https://github.com/octocat/hello-world/compare/553c2077f0ed...6dcb09b5b578",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+					Message:   "Update README.md",
+					Link:      "https://github.com/octocat/hello-world/commit/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionOpen,
+			PullRequest: scm.PullRequest{
+				Number: 1347,
+				Title:  "new-feature",
+				Body:   "Please pull these awesome changes",
+				Sha:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				Ref:    "refs/pull/1347/head",
+				Source: "new-topic",
+				Target: "master",
+				Fork:   "octocat/hello-world",
+				Link:   "https://github.com/octocat/hello-world/pull/1347",
+				Diff:   "https://github.com/octocat/hello-world/pull/1347.diff",
+				Head: scm.Reference{
+					Name: "new-topic",
+					Path: "refs/heads/new-topic",
+					Sha:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				},
+				Base: scm.Reference{
+					Name: "master",
+					Path: "refs/heads/master",
+					Sha:  "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e",
+				},
+				Author: scm.User{
+					Login:  "octocat",
+					Avatar: "https://github.com/images/error/octocat_happy.gif",
+				},
+				Labels:  []scm.Label{{Name: "bug", Color: "f29513"}},
+				Created: date,
+				Updated: date,
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, "topsecret")
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -42,6 +43,35 @@ func (s *userService) ListEmail(ctx context.Context, opts scm.ListOptions) ([]*s
 	return convertEmailList(out), res, err
 }
 
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("user/keys/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
 type user struct {
 	ID      int         `json:"id"`
 	Login   string      `json:"login"`
@@ -58,6 +88,31 @@ type email struct {
 	Verified bool   `json:"verified"`
 }
 
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+type gpgKey struct {
+	ID        int    `json:"id"`
+	KeyID     string `json:"key_id"`
+	PublicKey string `json:"public_key"`
+	RawKey    string `json:"raw_key"`
+	Emails    []struct {
+		Email    string `json:"email"`
+		Verified bool   `json:"verified"`
+	} `json:"emails"`
+	Created time.Time `json:"created_at"`
+	Expires null.Time `json:"expires_at"`
+}
+
 func convertUser(from *user) *scm.User {
 	return &scm.User{
 		Avatar:  from.Avatar,
@@ -97,3 +152,51 @@ func convertEmail(from *email) *scm.Email {
 		Verified: from.Verified,
 	}
 }
+
+// helper function to convert from the github key list to
+// the common key structure.
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+// helper function to convert from the github key structure to
+// the common key structure.
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      strconv.Itoa(from.ID),
+		Title:   from.Title,
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
+
+// helper function to convert from the github gpg key list to
+// the common gpg key structure.
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+// helper function to convert from the github gpg key structure
+// to the common gpg key structure. The armored key is only
+// included in the raw key field.
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	to := &scm.GPGKey{
+		ID:      strconv.Itoa(from.ID),
+		KeyID:   from.KeyID,
+		Key:     from.RawKey,
+		Created: from.Created,
+		Expires: from.Expires.ValueOrZero(),
+	}
+	for _, v := range from.Emails {
+		to.Emails = append(to.Emails, v.Email)
+	}
+	return to
+}
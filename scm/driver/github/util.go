@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -67,6 +68,27 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("sha", opts.Ref)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -80,6 +102,21 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("creator", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.Format(time.RFC3339))
+	}
+	if opts.Sort != scm.IssueSortDefault {
+		params.Set("sort", opts.Sort.String())
+	}
 	return params.Encode()
 }
 
@@ -130,3 +167,46 @@ func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Sha != "" {
+		params.Set("sha", opts.Sha)
+	}
+	if opts.Ref != "" {
+		params.Set("ref", opts.Ref)
+	}
+	if opts.Task != "" {
+		params.Set("task", opts.Task)
+	}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	return params.Encode()
+}
+
+func encodeSearchOptions(opts scm.SearchOptions) string {
+	// the search scope is expressed using qualifiers
+	// appended to the search query.
+	q := opts.Query
+	if opts.Repo != "" {
+		q += " repo:" + opts.Repo
+	} else if opts.Namespace != "" {
+		q += " user:" + opts.Namespace
+	}
+	params := url.Values{}
+	params.Set("q", q)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
@@ -6,6 +6,7 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -36,6 +37,20 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeFileCommitListOptions(t *testing.T) {
+	opts := scm.FileCommitListOptions{
+		Page:  10,
+		Size:  30,
+		Ref:   "master",
+		Since: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	want := "page=10&path=docs%2Freadme.md&per_page=30&sha=master&since=2020-01-02T03%3A04%3A05Z"
+	got := encodeFileCommitListOptions("docs/readme.md", opts)
+	if got != want {
+		t.Errorf("Want encoded file commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -64,6 +79,21 @@ func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Labels:   []string{"bug", "help wanted"},
+		Assignee: "octocat",
+		Author:   "hubot",
+		Since:    time.Date(2011, 4, 10, 20, 9, 31, 0, time.UTC),
+		Sort:     scm.IssueSortUpdated,
+	}
+	want := "assignee=octocat&creator=hubot&labels=bug%2Chelp+wanted&since=2011-04-10T20%3A09%3A31Z&sort=updated"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -30,7 +31,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-GitHub-Event") {
+	event := req.Header.Get("X-GitHub-Event")
+	switch event {
 	case "push":
 		hook, err = s.parsePushHook(data)
 	case "create":
@@ -41,18 +43,53 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePullRequestHook(data)
 	case "deployment":
 		hook, err = s.parseDeploymentHook(data)
-	// case "pull_request_review_comment":
-	// case "issues":
+	case "pull_request_review_comment":
+		hook, err = s.parseReviewCommentHook(data)
+	case "pull_request_review":
+		hook, err = s.parseReviewHook(data)
+	case "issues":
+		hook, err = s.parseIssueHook(data)
 	case "issue_comment":
 		hook, err = s.parseIssueCommentHook(data)
 	case "release":
 		hook, err = s.parseReleaseHook(data)
+	case "status":
+		hook, err = s.parseStatusHook(data)
+	case "check_run":
+		hook, err = s.parseCheckRunHook(data)
+	case "check_suite":
+		hook, err = s.parseCheckSuiteHook(data)
+	case "workflow_run":
+		hook, err = s.parseWorkflowRunHook(data)
+	case "ping":
+		hook, err = s.parsePingHook(data)
+	case "repository":
+		hook, err = s.parseRepositoryHook(data)
+	case "organization":
+		hook, err = s.parseOrganizationHook(data)
+	case "member":
+		hook, err = s.parseMemberHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverGithub,
+		GUID:     req.Header.Get("X-GitHub-Delivery"),
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -209,11 +246,177 @@ func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parseIssueHook(data []byte) (scm.Webhook, error) {
+	src := new(issueHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertIssueHook(src)
+	switch src.Action {
+	case "opened":
+		dst.Action = scm.ActionOpen
+	case "edited":
+		dst.Action = scm.ActionEdit
+	case "deleted":
+		dst.Action = scm.ActionDelete
+	case "closed":
+		dst.Action = scm.ActionClose
+	case "reopened":
+		dst.Action = scm.ActionReopen
+	case "labeled":
+		dst.Action = scm.ActionLabel
+	case "unlabeled":
+		dst.Action = scm.ActionUnlabel
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseReviewCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(reviewCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertReviewCommentHook(src)
+	switch src.Action {
+	case "created":
+		dst.Action = scm.ActionCreate
+	case "edited":
+		dst.Action = scm.ActionEdit
+	case "deleted":
+		dst.Action = scm.ActionDelete
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseReviewHook(data []byte) (scm.Webhook, error) {
+	src := new(reviewHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertReviewHook(src)
+	switch src.Action {
+	case "submitted":
+		dst.Action = scm.ActionSubmit
+	case "edited":
+		dst.Action = scm.ActionEdit
+	case "dismissed":
+		dst.Action = scm.ActionDismiss
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseStatusHook(data []byte) (scm.Webhook, error) {
+	src := new(statusHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertStatusHook(src), nil
+}
+
+func (s *webhookService) parseCheckRunHook(data []byte) (scm.Webhook, error) {
+	src := new(checkRunHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertCheckRunHook(src)
+	dst.Action = convertCheckAction(src.Action)
+	return dst, nil
+}
+
+func (s *webhookService) parseCheckSuiteHook(data []byte) (scm.Webhook, error) {
+	src := new(checkSuiteHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertCheckSuiteHook(src)
+	dst.Action = convertCheckAction(src.Action)
+	return dst, nil
+}
+
+func (s *webhookService) parseWorkflowRunHook(data []byte) (scm.Webhook, error) {
+	src := new(workflowRunHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertWorkflowRunHook(src)
+	dst.Action = convertCheckAction(src.Action)
+	return dst, nil
+}
+
+func (s *webhookService) parsePingHook(data []byte) (scm.Webhook, error) {
+	src := new(pingHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertPingHook(src), nil
+}
+
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	src := new(repositoryHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertRepositoryHook(src), nil
+}
+
+func (s *webhookService) parseOrganizationHook(data []byte) (scm.Webhook, error) {
+	src := new(organizationHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertOrganizationHook(src), nil
+}
+
+func (s *webhookService) parseMemberHook(data []byte) (scm.Webhook, error) {
+	src := new(memberHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemberHook(src), nil
+}
+
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst, nil
+}
+
 //
 // native data structures
 //
 
 type (
+	// github webhook payload for unrecognized events
+	rawHook struct {
+		Repository *repository `json:"repository"`
+	}
 	// github create webhook payload
 	createDeleteHook struct {
 		Ref        string     `json:"ref"`
@@ -351,6 +554,145 @@ type (
 		Repository repository `json:"repository"`
 		Sender     user       `json:"sender"`
 	}
+
+	// github issues webhook payload
+	issueHook struct {
+		Action     string     `json:"action"`
+		Issue      issue      `json:"issue"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github pull_request_review_comment webhook payload
+	reviewCommentHook struct {
+		Action      string     `json:"action"`
+		Comment     review     `json:"comment"`
+		PullRequest pr         `json:"pull_request"`
+		Repository  repository `json:"repository"`
+		Sender      user       `json:"sender"`
+	}
+
+	// github pull_request_review webhook payload
+	reviewHook struct {
+		Action      string           `json:"action"`
+		Review      reviewSubmission `json:"review"`
+		PullRequest pr               `json:"pull_request"`
+		Repository  repository       `json:"repository"`
+		Sender      user             `json:"sender"`
+	}
+
+	// github status webhook payload
+	statusHook struct {
+		ID          int64       `json:"id"`
+		Sha         string      `json:"sha"`
+		Context     string      `json:"context"`
+		Description null.String `json:"description"`
+		State       string      `json:"state"`
+		TargetURL   null.String `json:"target_url"`
+		Branches    []struct {
+			Name string `json:"name"`
+		} `json:"branches"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github check_run webhook payload
+	checkRunHook struct {
+		Action   string `json:"action"`
+		CheckRun struct {
+			ID          int64       `json:"id"`
+			Name        string      `json:"name"`
+			HeadSha     string      `json:"head_sha"`
+			Status      string      `json:"status"`
+			Conclusion  null.String `json:"conclusion"`
+			HTMLURL     string      `json:"html_url"`
+			StartedAt   null.Time   `json:"started_at"`
+			CompletedAt null.Time   `json:"completed_at"`
+		} `json:"check_run"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github check_suite webhook payload
+	checkSuiteHook struct {
+		Action     string `json:"action"`
+		CheckSuite struct {
+			ID         int64       `json:"id"`
+			HeadBranch null.String `json:"head_branch"`
+			HeadSha    string      `json:"head_sha"`
+			Status     string      `json:"status"`
+			Conclusion null.String `json:"conclusion"`
+			CreatedAt  time.Time   `json:"created_at"`
+			UpdatedAt  time.Time   `json:"updated_at"`
+		} `json:"check_suite"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github workflow_run webhook payload
+	workflowRunHook struct {
+		Action      string `json:"action"`
+		WorkflowRun struct {
+			ID         int64       `json:"id"`
+			Name       string      `json:"name"`
+			RunNumber  int         `json:"run_number"`
+			Event      string      `json:"event"`
+			HeadBranch string      `json:"head_branch"`
+			HeadSha    string      `json:"head_sha"`
+			Status     string      `json:"status"`
+			Conclusion null.String `json:"conclusion"`
+			HTMLURL    string      `json:"html_url"`
+			CreatedAt  time.Time   `json:"created_at"`
+			UpdatedAt  time.Time   `json:"updated_at"`
+		} `json:"workflow_run"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github ping webhook payload
+	pingHook struct {
+		Zen        string      `json:"zen"`
+		HookID     int64       `json:"hook_id"`
+		Repository *repository `json:"repository"`
+		Sender     user        `json:"sender"`
+	}
+
+	// github repository webhook payload, which is also
+	// delivered to organization webhooks.
+	repositoryHook struct {
+		Action  string `json:"action"`
+		Changes struct {
+			Repository struct {
+				Name struct {
+					From string `json:"from"`
+				} `json:"name"`
+			} `json:"repository"`
+			Owner struct {
+				From struct {
+					User         *user `json:"user"`
+					Organization *user `json:"organization"`
+				} `json:"from"`
+			} `json:"owner"`
+		} `json:"changes"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github organization webhook payload
+	organizationHook struct {
+		Action       string `json:"action"`
+		Organization user   `json:"organization"`
+		Sender       user   `json:"sender"`
+	}
+
+	// github member webhook payload, which is delivered when
+	// a collaborator is added to or removed from a repository.
+	memberHook struct {
+		Action     string     `json:"action"`
+		Member     user       `json:"member"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
 )
 
 //
@@ -580,6 +922,327 @@ func convertReleaseHook(src *releaseHook) *scm.ReleaseHook {
 	return dst
 }
 
+func convertIssueHook(src *issueHook) *scm.IssueHook {
+	return &scm.IssueHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		Issue:  *convertIssue(&src.Issue),
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertReviewCommentHook(src *reviewCommentHook) *scm.ReviewCommentHook {
+	return &scm.ReviewCommentHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		PullRequest: *convertPullRequest(&src.PullRequest),
+		Review:      *convertReview(&src.Comment),
+		Sender:      *convertUser(&src.Sender),
+	}
+}
+
+func convertReviewHook(src *reviewHook) *scm.ReviewHook {
+	dst := &scm.ReviewHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		PullRequest: *convertPullRequest(&src.PullRequest),
+		Review:      *convertReviewSubmission(&src.Review),
+		Sender:      *convertUser(&src.Sender),
+	}
+	// the review state is lowercase in the webhook payload,
+	// but uppercase in the rest api.
+	dst.Review.State = convertReviewState(strings.ToUpper(src.Review.State))
+	return dst
+}
+
+func convertStatusHook(src *statusHook) *scm.StatusHook {
+	dst := &scm.StatusHook{
+		Sha: src.Sha,
+		Status: scm.Status{
+			State:  convertState(src.State),
+			Label:  src.Context,
+			Desc:   src.Description.String,
+			Target: src.TargetURL.String,
+		},
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		Sender: *convertUser(&src.Sender),
+	}
+	for _, branch := range src.Branches {
+		dst.Branches = append(dst.Branches, branch.Name)
+	}
+	return dst
+}
+
+func convertCheckRunHook(src *checkRunHook) *scm.CheckRunHook {
+	return &scm.CheckRunHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		CheckRun: scm.CheckRun{
+			ID:        src.CheckRun.ID,
+			Name:      src.CheckRun.Name,
+			Sha:       src.CheckRun.HeadSha,
+			State:     convertCheckState(src.CheckRun.Status, src.CheckRun.Conclusion.String),
+			Link:      src.CheckRun.HTMLURL,
+			Started:   src.CheckRun.StartedAt.ValueOrZero(),
+			Completed: src.CheckRun.CompletedAt.ValueOrZero(),
+		},
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertCheckSuiteHook(src *checkSuiteHook) *scm.CheckSuiteHook {
+	return &scm.CheckSuiteHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		CheckSuite: scm.CheckSuite{
+			ID:      src.CheckSuite.ID,
+			Sha:     src.CheckSuite.HeadSha,
+			Branch:  src.CheckSuite.HeadBranch.String,
+			State:   convertCheckState(src.CheckSuite.Status, src.CheckSuite.Conclusion.String),
+			Created: src.CheckSuite.CreatedAt,
+			Updated: src.CheckSuite.UpdatedAt,
+		},
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertWorkflowRunHook(src *workflowRunHook) *scm.WorkflowRunHook {
+	return &scm.WorkflowRunHook{
+		Repo: scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		},
+		WorkflowRun: scm.WorkflowRun{
+			ID:      src.WorkflowRun.ID,
+			Name:    src.WorkflowRun.Name,
+			Number:  src.WorkflowRun.RunNumber,
+			Event:   src.WorkflowRun.Event,
+			Sha:     src.WorkflowRun.HeadSha,
+			Branch:  src.WorkflowRun.HeadBranch,
+			State:   convertCheckState(src.WorkflowRun.Status, src.WorkflowRun.Conclusion.String),
+			Link:    src.WorkflowRun.HTMLURL,
+			Created: src.WorkflowRun.CreatedAt,
+			Updated: src.WorkflowRun.UpdatedAt,
+		},
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertPingHook(src *pingHook) *scm.PingHook {
+	dst := &scm.PingHook{
+		HookID: fmt.Sprint(src.HookID),
+		Zen:    src.Zen,
+		Sender: *convertUser(&src.Sender),
+	}
+	// the repository is not included in the payload
+	// when pinging an organization webhook.
+	if src.Repository != nil {
+		dst.Repo = scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: convertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		}
+	}
+	return dst
+}
+
+func convertRepositoryHook(src *repositoryHook) *scm.RepositoryHook {
+	dst := &scm.RepositoryHook{
+		Action: convertRepositoryAction(src.Action),
+		Repo:   convertHookRepository(&src.Repository),
+		Sender: *convertUser(&src.Sender),
+	}
+	// the previous repository is only included in the
+	// payload when the repository is renamed or transferred.
+	switch src.Action {
+	case "renamed":
+		dst.Previous = dst.Repo
+		dst.Previous.Name = src.Changes.Repository.Name.From
+	case "transferred":
+		dst.Previous = dst.Repo
+		if from := src.Changes.Owner.From.Organization; from != nil {
+			dst.Previous.Namespace = from.Login
+		} else if from := src.Changes.Owner.From.User; from != nil {
+			dst.Previous.Namespace = from.Login
+		}
+	}
+	return dst
+}
+
+func convertOrganizationHook(src *organizationHook) *scm.SystemHook {
+	dst := &scm.SystemHook{
+		Event:  "organization",
+		Sender: *convertUser(&src.Sender),
+	}
+	switch src.Action {
+	case "member_added", "member_invited":
+		dst.Action = scm.ActionCreate
+	case "member_removed", "deleted":
+		dst.Action = scm.ActionDelete
+	case "renamed":
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
+func convertMemberHook(src *memberHook) *scm.SystemHook {
+	dst := &scm.SystemHook{
+		Event:  "member",
+		Repo:   convertHookRepository(&src.Repository),
+		Sender: *convertUser(&src.Sender),
+	}
+	switch src.Action {
+	case "added":
+		dst.Action = scm.ActionCreate
+	case "removed":
+		dst.Action = scm.ActionDelete
+	case "edited":
+		dst.Action = scm.ActionUpdate
+	}
+	return dst
+}
+
+func convertHookRepository(src *repository) scm.Repository {
+	return scm.Repository{
+		ID:         fmt.Sprint(src.ID),
+		Namespace:  src.Owner.Login,
+		Name:       src.Name,
+		Branch:     src.DefaultBranch,
+		Archived:   src.Archived,
+		Private:    src.Private,
+		Visibility: convertVisibility(src.Visibility),
+		Clone:      src.CloneURL,
+		CloneSSH:   src.SSHURL,
+		Link:       src.HTMLURL,
+	}
+}
+
+// helper function converts the repository action to the
+// common action.
+func convertRepositoryAction(from string) scm.Action {
+	switch from {
+	case "created":
+		return scm.ActionCreate
+	case "deleted":
+		return scm.ActionDelete
+	case "edited", "renamed", "transferred", "archived", "unarchived", "publicized", "privatized":
+		return scm.ActionUpdate
+	default:
+		return scm.ActionUnknown
+	}
+}
+
+// helper function converts the check run, check suite and
+// workflow run action to the common action.
+func convertCheckAction(from string) scm.Action {
+	switch from {
+	case "created":
+		return scm.ActionCreate
+	case "requested":
+		return scm.ActionRequest
+	case "rerequested":
+		return scm.ActionRerequest
+	case "in_progress":
+		return scm.ActionStart
+	case "completed":
+		return scm.ActionComplete
+	default:
+		return scm.ActionUnknown
+	}
+}
+
+// helper function converts the check run, check suite and
+// workflow run status and conclusion to the common state.
+func convertCheckState(status, conclusion string) scm.State {
+	switch status {
+	case "queued", "requested", "waiting", "pending":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	case "completed":
+	default:
+		return scm.StateUnknown
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return scm.StateSuccess
+	case "failure", "timed_out", "action_required", "startup_failure":
+		return scm.StateFailure
+	case "cancelled":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
+
 // regexp help determine if the named git object is a tag.
 // this is not meant to be 100% accurate.
 var tagRE = regexp.MustCompile("^v?(\\d+).(.+)")
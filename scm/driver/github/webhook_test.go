@@ -15,6 +15,7 @@ import (
 	"github.com/drone/go-scm/scm"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -235,6 +236,99 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/release_released.json.golden",
 			obj:    new(scm.ReleaseHook),
 		},
+		//
+		// issues
+		//
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_opened.json",
+			after:  "testdata/webhooks/issues_opened.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		//
+		// reviews
+		//
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/pr_review_comment_created.json",
+			after:  "testdata/webhooks/pr_review_comment_created.json.golden",
+			obj:    new(scm.ReviewCommentHook),
+		},
+		{
+			event:  "pull_request_review",
+			before: "testdata/webhooks/pr_review_submitted.json",
+			after:  "testdata/webhooks/pr_review_submitted.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+		//
+		// status, checks and workflows
+		//
+		{
+			event:  "status",
+			before: "testdata/webhooks/status.json",
+			after:  "testdata/webhooks/status.json.golden",
+			obj:    new(scm.StatusHook),
+		},
+		{
+			event:  "check_run",
+			before: "testdata/webhooks/check_run_completed.json",
+			after:  "testdata/webhooks/check_run_completed.json.golden",
+			obj:    new(scm.CheckRunHook),
+		},
+		{
+			event:  "check_suite",
+			before: "testdata/webhooks/check_suite_requested.json",
+			after:  "testdata/webhooks/check_suite_requested.json.golden",
+			obj:    new(scm.CheckSuiteHook),
+		},
+		{
+			event:  "workflow_run",
+			before: "testdata/webhooks/workflow_run_completed.json",
+			after:  "testdata/webhooks/workflow_run_completed.json.golden",
+			obj:    new(scm.WorkflowRunHook),
+		},
+		//
+		// ping
+		//
+		{
+			event:  "ping",
+			before: "testdata/webhooks/ping.json",
+			after:  "testdata/webhooks/ping.json.golden",
+			obj:    new(scm.PingHook),
+		},
+
+		//
+		// organization events
+		//
+
+		// repository created
+		{
+			event:  "repository",
+			before: "testdata/webhooks/repository_created.json",
+			after:  "testdata/webhooks/repository_created.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// repository renamed
+		{
+			event:  "repository",
+			before: "testdata/webhooks/repository_renamed.json",
+			after:  "testdata/webhooks/repository_renamed.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// organization member added
+		{
+			event:  "organization",
+			before: "testdata/webhooks/organization_member_added.json",
+			after:  "testdata/webhooks/organization_member_added.json.golden",
+			obj:    new(scm.SystemHook),
+		},
+		// repository collaborator added
+		{
+			event:  "member",
+			before: "testdata/webhooks/member_added.json",
+			after:  "testdata/webhooks/member_added.json.golden",
+			obj:    new(scm.SystemHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -268,7 +362,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 
@@ -352,6 +446,98 @@ func TestWebhookSignatureFallback(t *testing.T) {
 	}
 }
 
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "star")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverGithub; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "star"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "Hello-World"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookSignatureInvalid(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "star")
+	r.Header.Set("X-Hub-Signature", "sha1=380f462cd2e160b84765144beabdad2e930a7ec5")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "star")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-GitHub-Event", "push")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverGithub,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "push",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
@@ -91,6 +91,16 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, opts
 	return convertContentInfoList(out), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/files/%s/blame?ref=%s", encode(repo), encodePath(path), url.QueryEscape(ref))
+	out := []*blame{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertBlameList(out), res, err
+}
+
 type content struct {
 	FileName     string `json:"file_name"`
 	FilePath     string `json:"file_path"`
@@ -144,3 +154,29 @@ func convertContentInfo(from *object) *scm.ContentInfo {
 	}
 	return to
 }
+
+// blame groups consecutive lines of a file that were last
+// modified by the same commit.
+type blame struct {
+	Commit *commit  `json:"commit"`
+	Lines  []string `json:"lines"`
+}
+
+func convertBlameList(from []*blame) []*scm.BlameRange {
+	to := []*scm.BlameRange{}
+	line := 1
+	for _, v := range from {
+		// gitlab does not include line numbers, which are
+		// computed from the number of lines in each range.
+		blame := &scm.BlameRange{
+			StartLine: line,
+			EndLine:   line + len(v.Lines) - 1,
+		}
+		if v.Commit != nil {
+			blame.Commit = *convertCommit(v.Commit)
+		}
+		to = append(to, blame)
+		line += len(v.Lines)
+	}
+	return to
+}
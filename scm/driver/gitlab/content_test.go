@@ -81,6 +81,37 @@ func TestContentFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestContentBlame(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/files/app/models/key.rb/blame").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blame.json")
+
+	client := NewDefault()
+	got, res, err := client.Contents.Blame(context.Background(), "diaspora/diaspora", "app/models/key.rb", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.BlameRange{}
+	raw, _ := os.ReadFile("testdata/blame.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestContentCreate(t *testing.T) {
 	defer gock.Off()
 
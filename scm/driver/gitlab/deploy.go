@@ -0,0 +1,178 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo, id string) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%s", encode(repo), id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments?%s", encode(repo), encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments", encode(repo))
+	in := &deploymentInput{
+		Environment: input.Environment,
+		Sha:         input.Sha,
+		Ref:         scm.TrimRef(input.Ref),
+		Tag:         scm.IsTag(input.Ref),
+		// gitlab rejects the created status when creating a
+		// deployment, so it starts out as running.
+		Status: convertFromDeployState(scm.StateRunning),
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo, id string, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%s", encode(repo), id)
+	in := &deploymentInput{
+		Status: convertFromDeployState(input.State),
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+func (s *deploymentService) ListStatuses(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	// gitlab only tracks the current status of a deployment
+	// and does not expose the status history.
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/environments?%s", encode(repo), encodeListOptions(opts))
+	out := []*environment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertEnvironmentList(out), res, err
+}
+
+type deployment struct {
+	ID          int          `json:"id"`
+	Ref         string       `json:"ref"`
+	Sha         string       `json:"sha"`
+	Status      string       `json:"status"`
+	User        user         `json:"user"`
+	Environment *environment `json:"environment"`
+	Created     time.Time    `json:"created_at"`
+	Updated     time.Time    `json:"updated_at"`
+}
+
+type deploymentInput struct {
+	Environment string `json:"environment,omitempty"`
+	Sha         string `json:"sha,omitempty"`
+	Ref         string `json:"ref,omitempty"`
+	Tag         bool   `json:"tag,omitempty"`
+	Status      string `json:"status"`
+}
+
+type environment struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ExternalURL string    `json:"external_url"`
+	Created     time.Time `json:"created_at"`
+	Updated     time.Time `json:"updated_at"`
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	to := &scm.Deployment{
+		ID:      strconv.Itoa(from.ID),
+		Ref:     from.Ref,
+		Sha:     from.Sha,
+		State:   convertDeployState(from.Status),
+		Author:  *convertUser(&from.User),
+		Created: from.Created,
+		Updated: from.Updated,
+	}
+	if from.Environment != nil {
+		to.Environment = from.Environment.Name
+	}
+	return to
+}
+
+func convertDeployStatus(from *deployment) *scm.DeployStatus {
+	to := &scm.DeployStatus{
+		Number: int64(from.ID),
+		State:  convertDeployState(from.Status),
+	}
+	if from.Environment != nil {
+		to.Environment = from.Environment.Name
+		to.EnvironmentURL = from.Environment.ExternalURL
+	}
+	return to
+}
+
+func convertEnvironmentList(from []*environment) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *environment) *scm.Environment {
+	return &scm.Environment{
+		ID:      strconv.Itoa(from.ID),
+		Name:    from.Name,
+		Desc:    from.Description,
+		Link:    from.ExternalURL,
+		Created: from.Created,
+		Updated: from.Updated,
+	}
+}
+
+// helper function converts the deployment status, which
+// includes the created and blocked states that are not
+// used by commit statuses.
+func convertDeployState(from string) scm.State {
+	switch from {
+	case "created", "blocked":
+		return scm.StatePending
+	default:
+		return convertState(from)
+	}
+}
+
+// helper function converts the deployment state. Note
+// that gitlab only accepts running, success, failed and
+// canceled when updating a deployment.
+func convertFromDeployState(from scm.State) string {
+	switch from {
+	case scm.StatePending, scm.StateRunning:
+		return "running"
+	default:
+		return convertFromState(from)
+	}
+}
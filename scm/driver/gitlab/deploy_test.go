@@ -0,0 +1,197 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Find(context.Background(), "diaspora/diaspora", "42")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := os.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchParam("environment", "production").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deploys.json")
+
+	client := NewDefault()
+	opts := scm.DeploymentListOptions{Page: 1, Size: 30, Environment: "production"}
+	got, res, err := client.Deployments.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := os.ReadFile("testdata/deploys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/deployments").
+		JSON(map[string]interface{}{
+			"environment": "production",
+			"sha":         "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+			"ref":         "main",
+			"status":      "running",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	input := &scm.DeploymentInput{
+		Ref:         "refs/heads/main",
+		Sha:         "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Environment: "production",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := os.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/deployments/42").
+		JSON(map[string]interface{}{"status": "success"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	client := NewDefault()
+	input := &scm.DeployStatusInput{State: scm.StateSuccess}
+	got, res, err := client.Deployments.CreateStatus(context.Background(), "diaspora/diaspora", "42", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployStatus)
+	raw, _ := os.ReadFile("testdata/deploy_status.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentListStatuses(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Deployments.ListStatuses(context.Background(), "diaspora/diaspora", "42", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeploymentListEnvironments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/environments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/environments.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListEnvironments(context.Background(), "diaspora/diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Environment{}
+	raw, _ := os.ReadFile("testdata/environments.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
 )
 
 type gitService struct {
@@ -27,6 +28,29 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	in := url.Values{}
+	in.Set("tag_name", params.Name)
+	in.Set("ref", params.Sha)
+	if params.Message != "" {
+		in.Set("message", params.Message)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags?%s", encode(repo), in.Encode())
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertTagDetails(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), name)
 	out := new(branch)
@@ -43,7 +67,42 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s", encode(repo), scm.TrimRef(ref))
 	out := new(commit)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertCommit(out), res, err
+	if err != nil {
+		return convertCommit(out), res, err
+	}
+	to := convertCommit(out)
+	to.Verification, err = s.findSignature(ctx, repo, out.ID)
+	if err != nil {
+		return nil, res, err
+	}
+	return to, res, nil
+}
+
+// helper function returns the commit signature verification.
+// The signature is not included in the commit response, and
+// a not found error is returned for unsigned commits.
+func (s *gitService) findSignature(ctx context.Context, repo, sha string) (*scm.Verification, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/signature", encode(repo), sha)
+	out := new(signature)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 404 {
+		return &scm.Verification{Reason: "unsigned"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertSignature(out), nil
+}
+
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/diff", encode(repo), ref)
+	out := []*change{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := convertDiff(out)
+	return diff, res, err
 }
 
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
@@ -53,6 +112,13 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), url.PathEscape(name))
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTagDetails(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches?%s", encode(repo), encodeListOptions(opts))
 	out := []*branch{}
@@ -74,6 +140,13 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/commits?%s", encode(repo), encodeFileCommitListOptions(path, opts))
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/tags?%s", encode(repo), encodeListOptions(opts))
 	out := []*branch{}
@@ -95,6 +168,55 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Diffs), res, err
 }
 
+// Compare compares the head commit with the base commit. The
+// compare api does not return the behind count, so the commits
+// are also compared in the reverse direction.
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/compare?from=%s&to=%s", encode(repo), base, head)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/repository/compare?from=%s&to=%s", encode(repo), head, base)
+	reverse := new(compare)
+	res, err = s.client.do(ctx, "GET", path, nil, reverse)
+	if err != nil {
+		return nil, res, err
+	}
+	mergeBase, res, err := s.FindMergeBase(ctx, repo, base, head)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Comparison{
+		MergeBase: mergeBase.Sha,
+		Ahead:     len(out.Commits),
+		Behind:    len(reverse.Commits),
+		Commits:   convertCommitList(out.Commits),
+		Changes:   convertChangeList(out.Diffs),
+	}, res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	params := url.Values{}
+	params.Add("refs[]", base)
+	params.Add("refs[]", head)
+	path := fmt.Sprintf("api/v4/projects/%s/repository/merge_base?%s", encode(repo), params.Encode())
+	out := new(commit)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCommit(out), res, err
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/compare?from=%s&to=%s", encode(repo), descendant, ancestor)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return len(out.Commits) == 0, res, nil
+}
+
 type branch struct {
 	Name   string `json:"name"`
 	Commit struct {
@@ -102,6 +224,16 @@ type branch struct {
 	}
 }
 
+type tag struct {
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+	Target  string    `json:"target"`
+	Created null.Time `json:"created_at"`
+	Commit  struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
 type createBranch struct {
 	Branch string `json:"branch"`
 	Ref    string `json:"ref"`
@@ -120,8 +252,37 @@ type commit struct {
 	Created        time.Time `json:"created_at"`
 }
 
+type signature struct {
+	SignatureType      string `json:"signature_type"`
+	VerificationStatus string `json:"verification_status"`
+	GPGKeyUserName     string `json:"gpg_key_user_name"`
+	GPGKeyUserEmail    string `json:"gpg_key_user_email"`
+	X509Certificate    struct {
+		Email string `json:"email"`
+	} `json:"x509_certificate"`
+}
+
 type compare struct {
-	Diffs []*change `json:"diffs"`
+	Commits []*commit `json:"commits"`
+	Diffs   []*change `json:"diffs"`
+}
+
+func convertSignature(from *signature) *scm.Verification {
+	to := &scm.Verification{
+		Verified: from.VerificationStatus == "verified",
+		Reason:   from.VerificationStatus,
+	}
+	switch from.SignatureType {
+	case "PGP":
+		to.Type = "gpg"
+		to.Signer = from.GPGKeyUserEmail
+	case "X509":
+		to.Type = "x509"
+		to.Signer = from.X509Certificate.Email
+	case "SSH":
+		to.Type = "ssh"
+	}
+	return to
 }
 
 func convertCommitList(from []*commit) []*scm.Commit {
@@ -182,3 +343,21 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.Commit.ID,
 	}
 }
+
+// helper function to convert from the gitlab tag structure to
+// the common tag structure. The target is the tag object sha
+// for annotated tags, and the commit sha for lightweight tags.
+// Gitlab does not return the tagger identity.
+func convertTagDetails(from *tag) *scm.Tag {
+	to := &scm.Tag{
+		Name:    from.Name,
+		Path:    scm.ExpandRef(from.Name, "refs/tags"),
+		Message: from.Message,
+		Sha:     from.Commit.ID,
+	}
+	if from.Target != "" && from.Target != from.Commit.ID {
+		to.Object = from.Target
+		to.Tagger.Date = from.Created.ValueOrZero()
+	}
+	return to
+}
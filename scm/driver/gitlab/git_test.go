@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -30,6 +31,13 @@ func TestGitFindCommit(t *testing.T) {
 		SetHeaders(mockHeaders).
 		File("testdata/commit.json")
 
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit_signature.json")
+
 	client := NewDefault()
 	got, res, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
 	if err != nil {
@@ -50,6 +58,61 @@ func TestGitFindCommit(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitFindCommitUnsigned(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 GPG Signature Not Found"}`)
+
+	client := NewDefault()
+	got, _, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Verification{Reason: "unsigned"}
+	if diff := cmp.Diff(got.Verification, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindCommitSignatureError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(500).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"500 Internal Server Error"}`)
+
+	client := NewDefault()
+	_, _, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+	if err == nil {
+		t.Errorf("Expect signature error returned")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -137,6 +200,115 @@ func TestGitFindTag(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tags/v1.1.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_annotated.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTagDetails(context.Background(), "diaspora/diaspora", "v1.1.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_annotated.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/tags").
+		MatchParam("tag_name", "v1.1.0").
+		MatchParam("ref", "2695effb5807a22ff3d138d593fd856244e155e7").
+		MatchParam("message", "Release v1.1.0").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_annotated.json")
+
+	params := &scm.TagInput{
+		Name:    "v1.1.0",
+		Sha:     "2695effb5807a22ff3d138d593fd856244e155e7",
+		Message: "Release v1.1.0",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.CreateTag(context.Background(), "diaspora/diaspora", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag_annotated.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/tags/v1.0.0").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/branches/feature").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "diaspora/diaspora", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -172,6 +344,46 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("api/v4/projects/diaspora/diaspora/repository/commits").
+		MatchParam("path", "README").
+		MatchParam("ref_name", "master").
+		MatchParam("since", "2012-01-01T00:00:00Z").
+		MatchParam("until", "2013-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{
+		Ref:   "master",
+		Since: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2013, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.ListFileCommits(context.Background(), "diaspora/diaspora", "README", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -272,6 +484,36 @@ func TestGitListTags(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit_diffs.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindCommitDiff(context.Background(), "diaspora/diaspora", "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit_diffs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitListChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -333,3 +575,105 @@ func TestGitCompareChanges(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/compare").
+		MatchParam("from", "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba").
+		MatchParam("to", "6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/compare").
+		MatchParam("from", "6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		MatchParam("to", "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare_reverse.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/merge_base").
+		MatchParam("refs[]", "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_base.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.Compare(context.Background(), "diaspora/diaspora", "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba", "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.MergeBase, "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+	if got, want := got.Ahead, 1; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/compare.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindMergeBase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/merge_base").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_base.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindMergeBase(context.Background(), "diaspora/diaspora", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Sha, "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"; got != want {
+		t.Errorf("Want merge base %s, got %s", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/compare").
+		MatchParam("from", "feature").
+		MatchParam("to", "master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/compare_reverse.json")
+
+	client := NewDefault()
+	got, _, err := client.Git.IsAncestor(context.Background(), "diaspora/diaspora", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want master to be an ancestor of feature")
+	}
+}
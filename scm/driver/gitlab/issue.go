@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -47,12 +49,32 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	in := url.Values{}
+	in, res, err := s.encodeIssueInput(ctx, input)
+	if err != nil {
+		return nil, res, err
+	}
 	in.Set("title", input.Title)
 	in.Set("description", input.Body)
 	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), in.Encode())
 	out := new(issue)
-	res, err := s.client.do(ctx, "POST", path, nil, out)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	return convertIssue(out), res, err
+}
+
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	in, res, err := s.encodeIssueInput(ctx, input)
+	if err != nil {
+		return nil, res, err
+	}
+	if input.Title != "" {
+		in.Set("title", input.Title)
+	}
+	if input.Body != "" {
+		in.Set("description", input.Body)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	out := new(issue)
+	res, err = s.client.do(ctx, "PUT", path, nil, out)
 	return convertIssue(out), res, err
 }
 
@@ -76,6 +98,47 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("add_labels", strings.Join(labels, ","))
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("remove_labels", label)
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, res, err := lookupUserIDs(ctx, s.client, logins)
+	if err != nil {
+		return res, err
+	}
+	// gitlab removes all assignees when the assignee
+	// list is zero.
+	if len(ids) == 0 {
+		ids = []string{"0"}
+	}
+	in := url.Values{}
+	in.Set("assignee_ids", strings.Join(ids, ","))
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?milestone_id=%d", encode(repo), number, milestone)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?discussion_locked=true", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -88,6 +151,26 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return res, err
 }
 
+// helper function encodes the labels, assignees and
+// milestone of the issue input.
+func (s *issueService) encodeIssueInput(ctx context.Context, input *scm.IssueInput) (url.Values, *scm.Response, error) {
+	in := url.Values{}
+	if len(input.Labels) != 0 {
+		in.Set("labels", strings.Join(input.Labels, ","))
+	}
+	if len(input.Assignees) != 0 {
+		ids, res, err := lookupUserIDs(ctx, s.client, input.Assignees)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Set("assignee_ids", strings.Join(ids, ","))
+	}
+	if input.Milestone != 0 {
+		in.Set("milestone_id", strconv.Itoa(input.Milestone))
+	}
+	return in, nil, nil
+}
+
 type issue struct {
 	ID     int      `json:"id"`
 	Number int      `json:"iid"`
@@ -0,0 +1,123 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels?%s", encode(repo), encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	in := url.Values{}
+	in.Set("name", input.Name)
+	in.Set("color", convertFromLabelColor(input.Color))
+	if input.Description != "" {
+		in.Set("description", input.Description)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/labels?%s", encode(repo), in.Encode())
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	in := url.Values{}
+	if input.Name != "" {
+		in.Set("new_name", input.Name)
+	}
+	if input.Color != "" {
+		in.Set("color", convertFromLabelColor(input.Color))
+	}
+	if input.Description != "" {
+		in.Set("description", input.Description)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s?%s", encode(repo), url.PathEscape(name), in.Encode())
+	out := new(label)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddToIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabels(ctx, repo, number, []string{name})
+}
+
+func (s *labelService) RemoveFromIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, name)
+}
+
+func (s *labelService) AddToPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("add_labels", name)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *labelService) RemoveFromPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	in := url.Values{}
+	in.Set("remove_labels", name)
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+type label struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Color:       strings.TrimPrefix(from.Color, "#"),
+		Description: from.Description,
+	}
+}
+
+// helper function converts the hex color code to the gitlab
+// color format, which requires a leading hash.
+func convertFromLabelColor(from string) string {
+	if from == "" {
+		return from
+	}
+	return "#" + strings.TrimPrefix(from, "#")
+}
@@ -34,6 +34,41 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s", encode(name), id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(name), encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookInput(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(name), params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookInput(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s?%s", encode(name), id, params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s", encode(name), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type organization struct {
 	Name   string      `json:"name"`
 	Path   string      `json:"path"`
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -31,6 +32,17 @@ func (s *pullService) FindComment(ctx context.Context, repo string, index, id in
 	return convertIssueComment(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes", encode(repo), number)
+	out := new(changes)
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := convertDiff(out.Changes)
+	return diff, res, err
+}
+
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests?%s", encode(repo), encodePullRequestListOptions(opts))
 	out := []*pr{}
@@ -92,6 +104,34 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	// the rebase and fast-forward merge methods are configured
+	// at the project level and cannot be selected per merge.
+	switch input.Method {
+	case scm.MergeMethodRebase, scm.MergeMethodFastForward:
+		return nil, scm.ErrNotSupported
+	}
+	in := url.Values{}
+	message := scm.JoinCommitMessage(input.CommitTitle, input.CommitMessage)
+	if input.Method == scm.MergeMethodSquash {
+		in.Set("squash", "true")
+		if message != "" {
+			in.Set("squash_commit_message", message)
+		}
+	} else if message != "" {
+		in.Set("merge_commit_message", message)
+	}
+	if input.Sha != "" {
+		in.Set("sha", input.Sha)
+	}
+	if input.DeleteSourceBranch {
+		in.Set("should_remove_source_branch", "true")
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/merge?%s", encode(repo), number, in.Encode())
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	in := url.Values{}
 	if input.Title != "" {
@@ -148,9 +188,12 @@ type changes struct {
 type change struct {
 	OldPath string `json:"old_path"`
 	NewPath string `json:"new_path"`
+	OldMode string `json:"a_mode"`
+	NewMode string `json:"b_mode"`
 	Added   bool   `json:"new_file"`
 	Renamed bool   `json:"renamed_file"`
 	Deleted bool   `json:"deleted_file"`
+	Diff    string `json:"diff"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -213,3 +256,23 @@ func convertChange(from *change) *scm.Change {
 	}
 	return to
 }
+
+// convertDiff joins the per-file diffs returned by
+// gitlab, which do not include git file headers, into
+// a unified diff.
+func convertDiff(from []*change) (*scm.Diff, error) {
+	var b strings.Builder
+	for _, v := range from {
+		file := &scm.FileDiff{
+			OldPath: v.OldPath,
+			NewPath: v.NewPath,
+			OldMode: v.OldMode,
+			NewMode: v.NewMode,
+			Added:   v.Added,
+			Deleted: v.Deleted,
+			Renamed: v.Renamed,
+		}
+		b.WriteString(scm.FormatFileDiff(file, v.Diff))
+	}
+	return scm.ParseDiff(b.String())
+}
@@ -45,6 +45,36 @@ func TestPullFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/changes").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_changes.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.FindDiff(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit_diffs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -129,6 +159,46 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/merge").
+		MatchParam("squash", "true").
+		MatchParam("squash_commit_message", "Amazing new feature").
+		MatchParam("sha", "12d65c8dd2b2676fa3ac47d955accc085a37a9c1").
+		MatchParam("should_remove_source_branch", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Amazing new feature",
+		Sha:                "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.MergeWithOptions(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMergeWithOptions_Rebase(t *testing.T) {
+	client := NewDefault()
+	input := &scm.MergeInput{Method: scm.MergeMethodRebase}
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -6,7 +6,9 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -33,6 +35,7 @@ type repository struct {
 }
 
 type namespace struct {
+	ID       int    `json:"id"`
 	Name     string `json:"name"`
 	Path     string `json:"path"`
 	FullPath string `json:"full_path"`
@@ -132,34 +135,7 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 }
 
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	params := url.Values{}
-	params.Set("url", input.Target)
-	if input.Secret != "" {
-		params.Set("token", input.Secret)
-	}
-	if input.SkipVerify {
-		params.Set("enable_ssl_verification", "false")
-	}
-	if input.Events.Branch {
-		// no-op
-	}
-	if input.Events.Issue {
-		params.Set("issues_events", "true")
-	}
-	if input.Events.IssueComment ||
-		input.Events.PullRequestComment {
-		params.Set("note_events", "true")
-	}
-	if input.Events.PullRequest {
-		params.Set("merge_requests_events", "true")
-	}
-	if input.Events.Push || input.Events.Branch {
-		params.Set("push_events", "true")
-	}
-	if input.Events.Tag {
-		params.Set("tag_push_events", "true")
-	}
-
+	params := encodeHookInput(input)
 	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), params.Encode())
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, nil, out)
@@ -186,6 +162,130 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events?%s", encode(repo), id, encodeListOptions(opts))
+	out := []*hookEvent{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookEventList(id, out), res, err
+}
+
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	// gitlab does not provide an endpoint to get a single
+	// hook event, however the list includes the request
+	// and response, so we page through the list instead.
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		list, res, err := s.ListHookDeliveries(ctx, repo, id, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range list {
+			if v.ID == delivery {
+				return v, res, nil
+			}
+		}
+		if res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events/%s/resend", encode(repo), id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// gitlab does not support initializing a project
+	// with a gitignore template.
+	if input.Gitignore != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	params := encodeRepositoryInput(input)
+	if input.Namespace != "" {
+		ns := new(namespace)
+		path := fmt.Sprintf("api/v4/namespaces/%s", encode(input.Namespace))
+		res, err := s.client.do(ctx, "GET", path, nil, ns)
+		if err != nil {
+			return nil, res, err
+		}
+		params.Set("namespace_id", strconv.Itoa(ns.ID))
+	}
+	if input.Readme {
+		params.Set("initialize_with_readme", "true")
+	}
+	path := fmt.Sprintf("api/v4/projects?%s", params.Encode())
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	params := url.Values{}
+	if namespace != "" {
+		params.Set("namespace_path", namespace)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/fork?%s", encode(repo), params.Encode())
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	params := encodeRepositoryInput(input)
+	// the name is only the display name in gitlab, so the
+	// path is also updated to rename the repository.
+	if input.Name != "" {
+		params.Set("path", input.Name)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s?%s", encode(repo), params.Encode())
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	if err != nil || !input.Archived {
+		return convertRepository(out), res, err
+	}
+	// gitlab archives the repository with a separate endpoint,
+	// after the settings are updated.
+	path = fmt.Sprintf("api/v4/projects/%d/archive", out.ID)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/archive", encode(repo))
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *repositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/unarchive", encode(repo))
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function encodes the repository settings shared
+// by the create and update endpoints.
+func encodeRepositoryInput(from *scm.RepositoryInput) url.Values {
+	params := url.Values{}
+	if from.Name != "" {
+		params.Set("name", from.Name)
+	}
+	if from.Description != "" {
+		params.Set("description", from.Description)
+	}
+	if from.Branch != "" {
+		params.Set("default_branch", from.Branch)
+	}
+	if v := convertFromVisibility(from.Visibility); v != "" {
+		params.Set("visibility", v)
+	}
+	return params
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -228,6 +328,37 @@ func convertRepository(from *repository) *scm.Repository {
 	return to
 }
 
+func encodeHookInput(input *scm.HookInput) url.Values {
+	params := url.Values{}
+	params.Set("url", input.Target)
+	if input.Secret != "" {
+		params.Set("token", input.Secret)
+	}
+	if input.SkipVerify {
+		params.Set("enable_ssl_verification", "false")
+	}
+	if input.Events.Branch {
+		// no-op
+	}
+	if input.Events.Issue {
+		params.Set("issues_events", "true")
+	}
+	if input.Events.IssueComment ||
+		input.Events.PullRequestComment {
+		params.Set("note_events", "true")
+	}
+	if input.Events.PullRequest {
+		params.Set("merge_requests_events", "true")
+	}
+	if input.Events.Push || input.Events.Branch {
+		params.Set("push_events", "true")
+	}
+	if input.Events.Tag {
+		params.Set("tag_push_events", "true")
+	}
+	return params
+}
+
 func convertHookList(from []*hook) []*scm.Hook {
 	to := []*scm.Hook{}
 	for _, v := range from {
@@ -246,6 +377,63 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+type hookEvent struct {
+	ID                int               `json:"id"`
+	URL               string            `json:"url"`
+	Trigger           string            `json:"trigger"`
+	RequestHeaders    map[string]string `json:"request_headers"`
+	RequestData       json.RawMessage   `json:"request_data"`
+	ResponseHeaders   json.RawMessage   `json:"response_headers"`
+	ResponseBody      string            `json:"response_body"`
+	ExecutionDuration float64           `json:"execution_duration"`
+	ResponseStatus    string            `json:"response_status"`
+}
+
+func convertHookEventList(hookID string, from []*hookEvent) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookEvent(hookID, v))
+	}
+	return to
+}
+
+func convertHookEvent(hookID string, from *hookEvent) *scm.HookDelivery {
+	// the response status is a string that contains an
+	// error message if the request could not be sent.
+	status, _ := strconv.Atoi(from.ResponseStatus)
+	request := convertHookEventHeader(from.RequestHeaders)
+	// the response headers are replaced with a message
+	// string when they exceed the maximum size.
+	headers := map[string]string{}
+	_ = json.Unmarshal(from.ResponseHeaders, &headers)
+	return &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		HookID:   hookID,
+		GUID:     request.Get("X-Gitlab-Event-UUID"),
+		Event:    from.Trigger,
+		Target:   from.URL,
+		Status:   status,
+		Success:  status >= 200 && status < 300,
+		Duration: time.Duration(from.ExecutionDuration * float64(time.Second)),
+		Request: scm.HookDeliveryMessage{
+			Header: request,
+			Body:   string(from.RequestData),
+		},
+		Response: scm.HookDeliveryMessage{
+			Header: convertHookEventHeader(headers),
+			Body:   from.ResponseBody,
+		},
+	}
+}
+
+func convertHookEventHeader(from map[string]string) http.Header {
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
+
 type status struct {
 	Name    string      `json:"name"`
 	Desc    null.String `json:"description"`
@@ -348,6 +536,19 @@ func convertVisibility(from string) scm.Visibility {
 	}
 }
 
+func convertFromVisibility(from scm.Visibility) string {
+	switch from {
+	case scm.VisibilityPublic:
+		return "public"
+	case scm.VisibilityPrivate:
+		return "private"
+	case scm.VisibilityInternal:
+		return "internal"
+	default:
+		return ""
+	}
+}
+
 func canPush(proj *repository) bool {
 	switch {
 	case proj.Permissions.ProjectAccess.AccessLevel >= 30:
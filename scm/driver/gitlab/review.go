@@ -6,6 +6,13 @@ package gitlab
 
 import (
 	"context"
+	"crypto/sha1"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +22,466 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d", encode(repo), number, id)
+	out := new(note)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertNote(out, ""), res, err
 }
 
+// List returns the diff notes of the merge request discussions.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+	out := []*discussion{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDiscussionList(out), res, err
 }
 
+// Create starts a merge request discussion on the diff, or adds
+// a note to the discussion identified by the thread or by the
+// note that is replied to.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	thread := input.Thread
+	if thread == "" && input.InReplyTo != 0 {
+		// gitlab adds replies to the discussion, which
+		// is found by the note id.
+		var res *scm.Response
+		var err error
+		thread, res, err = s.findDiscussion(ctx, repo, number, input.InReplyTo)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if thread != "" {
+		in := url.Values{}
+		in.Set("body", input.Body)
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s/notes?%s", encode(repo), number, thread, in.Encode())
+		out := new(note)
+		res, err := s.client.do(ctx, "POST", path, nil, out)
+		return convertNote(out, thread), res, err
+	}
+	// the diff position requires the merge request diff
+	// refs, which are fetched from the merge request.
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	mr := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, mr)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &discussionInput{
+		Body:     input.Body,
+		Position: convertFromReviewInput(input, mr),
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions", encode(repo), number)
+	out := new(discussion)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil || len(out.Notes) == 0 {
+		return nil, res, err
+	}
+	return convertNote(out.Notes[0], out.ID), res, err
+}
+
+// helper function returns the id of the merge request
+// discussion that includes the note. The discussion cannot
+// be derived from the note, so we page through the list of
+// discussions instead.
+func (s *reviewService) findDiscussion(ctx context.Context, repo string, number, id int) (string, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+		out := []*discussion{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return "", res, err
+		}
+		for _, v := range out {
+			for _, n := range v.Notes {
+				if n.ID == id {
+					return v.ID, res, nil
+				}
+			}
+		}
+		if res.Page.Next == 0 {
+			return "", res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d", encode(repo), number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s?resolved=true", encode(repo), number, thread)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s?resolved=false", encode(repo), number, thread)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// Submit approves the merge request, or comments on the merge
+// request. Gitlab does not support requesting changes or
+// submitting a batch of review comments.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	if len(input.Comments) != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	switch input.State {
+	case scm.ReviewStateApproved:
+	case scm.ReviewStateCommented:
+		if input.Body == "" {
+			return nil, nil, scm.ErrNotSupported
+		}
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	to := &scm.ReviewSubmission{
+		Body:  input.Body,
+		State: input.State,
+		Sha:   input.Sha,
+	}
+	var res *scm.Response
+	var err error
+	if input.Body != "" {
+		in := url.Values{}
+		in.Set("body", input.Body)
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes?%s", encode(repo), number, in.Encode())
+		out := new(issueComment)
+		res, err = s.client.do(ctx, "POST", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		to.ID = out.ID
+		to.Author = scm.User{
+			Name:   out.User.Name,
+			Login:  out.User.Username,
+			Avatar: out.User.AvatarURL,
+		}
+		to.Submitted = out.CreatedAt
+	}
+	if input.State == scm.ReviewStateApproved {
+		in := url.Values{}
+		if input.Sha != "" {
+			in.Set("sha", input.Sha)
+		}
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approve?%s", encode(repo), number, in.Encode())
+		res, err = s.client.do(ctx, "POST", path, nil, nil)
+	}
+	return to, res, err
+}
+
+// ListSubmissions returns the merge request approvals.
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertApprovalList(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// ListReviewers returns the merge request reviewers and the
+// approvers. An approver that is not a reviewer is included
+// in the list as an approved reviewer.
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/reviewers", encode(repo), number)
+	reviewers := []*reviewer{}
+	res, err = s.client.do(ctx, "GET", path, nil, &reviewers)
+	return convertReviewerList(reviewers, out), res, err
+}
+
+// RequestReviewers adds the users to the merge request
+// reviewers. Gitlab replaces the reviewers when the merge
+// request is updated, so the users are merged with the
+// existing reviewers.
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, res, err := lookupUserIDs(ctx, s.client, logins)
+	if err != nil {
+		return res, err
+	}
+	current, res, err := s.listReviewerIDs(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	for _, id := range ids {
+		if !slices.Contains(current, id) {
+			current = append(current, id)
+		}
+	}
+	return s.setReviewers(ctx, repo, number, current)
+}
+
+// RemoveReviewers removes the users from the merge request
+// reviewers.
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	ids, res, err := lookupUserIDs(ctx, s.client, logins)
+	if err != nil {
+		return res, err
+	}
+	current, res, err := s.listReviewerIDs(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	var keep []string
+	for _, id := range current {
+		if !slices.Contains(ids, id) {
+			keep = append(keep, id)
+		}
+	}
+	return s.setReviewers(ctx, repo, number, keep)
+}
+
+// helper function returns the user ids of the merge
+// request reviewers.
+func (s *reviewService) listReviewerIDs(ctx context.Context, repo string, number int) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/reviewers", encode(repo), number)
+	out := []*reviewer{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	var ids []string
+	for _, v := range out {
+		ids = append(ids, strconv.Itoa(v.User.ID))
+	}
+	return ids, res, nil
+}
+
+// helper function replaces the merge request reviewers.
+func (s *reviewService) setReviewers(ctx context.Context, repo string, number int, ids []string) (*scm.Response, error) {
+	// gitlab removes all reviewers when the reviewer
+	// list is zero.
+	if len(ids) == 0 {
+		ids = []string{"0"}
+	}
+	in := url.Values{}
+	in.Set("reviewer_ids", strings.Join(ids, ","))
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?%s", encode(repo), number, in.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+type discussion struct {
+	ID    string  `json:"id"`
+	Notes []*note `json:"notes"`
+}
+
+type note struct {
+	ID         int       `json:"id"`
+	Type       string    `json:"type"`
+	Body       string    `json:"body"`
+	Author     user      `json:"author"`
+	Resolvable bool      `json:"resolvable"`
+	Resolved   bool      `json:"resolved"`
+	Position   *position `json:"position"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type position struct {
+	BaseSha      string     `json:"base_sha"`
+	StartSha     string     `json:"start_sha"`
+	HeadSha      string     `json:"head_sha"`
+	PositionType string     `json:"position_type"`
+	OldPath      string     `json:"old_path"`
+	NewPath      string     `json:"new_path"`
+	OldLine      int        `json:"old_line,omitempty"`
+	NewLine      int        `json:"new_line,omitempty"`
+	LineRange    *lineRange `json:"line_range,omitempty"`
+}
+
+type lineRange struct {
+	Start *linePosition `json:"start"`
+	End   *linePosition `json:"end"`
+}
+
+type linePosition struct {
+	LineCode string `json:"line_code"`
+	Type     string `json:"type"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
+}
+
+type discussionInput struct {
+	Body     string    `json:"body"`
+	Position *position `json:"position"`
+}
+
+type approvals struct {
+	ApprovalsRequired int `json:"approvals_required"`
+	ApprovedBy        []struct {
+		User user `json:"user"`
+	} `json:"approved_by"`
+}
+
+type reviewer struct {
+	User      user      `json:"user"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// helper function converts the diff notes of the discussions.
+// The first note starts the discussion, and the following notes
+// are replies to the first note.
+func convertDiscussionList(from []*discussion) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		if len(v.Notes) == 0 || v.Notes[0].Position == nil {
+			continue
+		}
+		for i, n := range v.Notes {
+			review := convertNote(n, v.ID)
+			if i != 0 {
+				review.InReplyTo = v.Notes[0].ID
+			}
+			to = append(to, review)
+		}
+	}
+	return to
+}
+
+func convertNote(from *note, thread string) *scm.Review {
+	to := &scm.Review{
+		ID:       from.ID,
+		Body:     from.Body,
+		Thread:   thread,
+		Resolved: from.Resolved,
+		Author:   *convertUser(&from.Author),
+		Created:  from.CreatedAt,
+		Updated:  from.UpdatedAt,
+	}
+	if p := from.Position; p != nil {
+		to.Sha = p.HeadSha
+		to.Path = p.NewPath
+		to.Line = p.NewLine
+		if p.NewLine == 0 {
+			to.Path = p.OldPath
+			to.Line = p.OldLine
+			to.Side = scm.DiffSideBase
+		}
+		to.EndLine = to.Line
+		if r := p.LineRange; r != nil && r.Start != nil {
+			start := r.Start.NewLine
+			if to.Side == scm.DiffSideBase {
+				start = r.Start.OldLine
+			}
+			if start != to.Line {
+				to.StartLine = start
+			}
+		}
+	}
+	return to
+}
+
+// helper function converts the review input to the diff
+// position of the merge request.
+func convertFromReviewInput(from *scm.ReviewInput, mr *pr) *position {
+	to := &position{
+		BaseSha:      mr.DiffRefs.BaseSha,
+		StartSha:     mr.DiffRefs.StartSha,
+		HeadSha:      mr.DiffRefs.HeadSha,
+		PositionType: "text",
+		OldPath:      from.Path,
+		NewPath:      from.Path,
+	}
+	if from.Sha != "" {
+		to.HeadSha = from.Sha
+	}
+	// the line number defaults to the line for compatibility
+	// with callers that do not set the end line.
+	line := from.EndLine
+	if line == 0 {
+		line = from.Line
+	}
+	if from.Side == scm.DiffSideBase {
+		to.OldLine = line
+	} else {
+		to.NewLine = line
+	}
+	if from.StartLine != 0 && from.StartLine != line {
+		to.LineRange = &lineRange{
+			Start: convertLinePosition(from.Path, from.StartLine, from.Side),
+			End:   convertLinePosition(from.Path, line, from.Side),
+		}
+	}
+	return to
+}
+
+// helper function returns the line position of the line range.
+// The line code is the sha1 hash of the path followed by the
+// old and new line numbers.
+func convertLinePosition(path string, line int, side scm.DiffSide) *linePosition {
+	hash := sha1.Sum([]byte(path))
+	if side == scm.DiffSideBase {
+		return &linePosition{
+			LineCode: fmt.Sprintf("%x_%d_%d", hash, line, 0),
+			Type:     "old",
+			OldLine:  line,
+		}
+	}
+	return &linePosition{
+		LineCode: fmt.Sprintf("%x_%d_%d", hash, 0, line),
+		Type:     "new",
+		NewLine:  line,
+	}
+}
+
+func convertApprovalList(from *approvals) []*scm.ReviewSubmission {
+	to := []*scm.ReviewSubmission{}
+	for _, v := range from.ApprovedBy {
+		to = append(to, &scm.ReviewSubmission{
+			State:  scm.ReviewStateApproved,
+			Author: *convertUser(&v.User),
+		})
+	}
+	return to
+}
+
+func convertReviewerList(from []*reviewer, approvals *approvals) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	index := map[string]*scm.Reviewer{}
+	for _, v := range from {
+		reviewer := &scm.Reviewer{
+			User:  *convertUser(&v.User),
+			State: convertReviewState(v.State),
+		}
+		index[v.User.Username] = reviewer
+		to = append(to, reviewer)
+	}
+	for _, v := range approvals.ApprovedBy {
+		if reviewer, ok := index[v.User.Username]; ok {
+			reviewer.State = scm.ReviewStateApproved
+			continue
+		}
+		to = append(to, &scm.Reviewer{
+			User:  *convertUser(&v.User),
+			State: scm.ReviewStateApproved,
+		})
+	}
+	return to
+}
+
+func convertReviewState(from string) scm.ReviewState {
+	switch from {
+	case "unreviewed", "review_started", "unapproved":
+		return scm.ReviewStatePending
+	case "reviewed":
+		return scm.ReviewStateCommented
+	case "approved":
+		return scm.ReviewStateApproved
+	case "requested_changes":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
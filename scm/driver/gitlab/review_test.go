@@ -6,39 +6,398 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
-func TestReviewFind(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Find(context.Background(), "diaspora/diaspora", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/discussions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
 	}
-}
 
-func TestReviewList(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	want := []*scm.Review{}
+	raw, _ := os.ReadFile("testdata/discussions.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
 }
 
 func TestReviewCreate(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Create(context.Background(), "diaspora/diaspora", 1, nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"iid": 1, "diff_refs": {"base_sha": "b5d6e7b1613fca24d250fa8e5bc7bcc3dd6002ef", "head_sha": "4803c71e6b1833ca72b8b26ef2ecd5adc8a38031", "start_sha": "7c9c2ead8a320fb7ba0b4e234bd9529a2614e306"}}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		JSON(map[string]interface{}{
+			"body": "diff comment",
+			"position": map[string]interface{}{
+				"base_sha":      "b5d6e7b1613fca24d250fa8e5bc7bcc3dd6002ef",
+				"start_sha":     "7c9c2ead8a320fb7ba0b4e234bd9529a2614e306",
+				"head_sha":      "4803c71e6b1833ca72b8b26ef2ecd5adc8a38031",
+				"position_type": "text",
+				"old_path":      "package.json",
+				"new_path":      "package.json",
+				"new_line":      27,
+				"line_range": map[string]interface{}{
+					"start": map[string]interface{}{
+						"line_code": "7030d0b2f71b999ff89a343de08c414af32fc93a_0_25",
+						"type":      "new",
+						"new_line":  25,
+					},
+					"end": map[string]interface{}{
+						"line_code": "7030d0b2f71b999ff89a343de08c414af32fc93a_0_27",
+						"type":      "new",
+						"new_line":  27,
+					},
+				},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussion.json")
+
+	input := &scm.ReviewInput{
+		Body:      "diff comment",
+		Path:      "package.json",
+		StartLine: 25,
+		Line:      27,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := os.ReadFile("testdata/discussions.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewCreateReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6/notes").
+		MatchParam("body", "reply to the diff comment").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id": 1129, "type": "DiffNote", "body": "reply to the diff comment", "author": {"username": "jane_doe"}}`)
+
+	input := &scm.ReviewInput{
+		Body:   "reply to the diff comment",
+		Thread: "87805b7c09016a7058e91bdbe7b29d1f284a39e6",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.ID != 1129 || got.Thread != input.Thread {
+		t.Errorf("Want reply 1129 in thread %s, got %d in thread %s", input.Thread, got.ID, got.Thread)
+	}
+}
+
+func TestReviewCreateInReplyTo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussions.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6/notes").
+		MatchParam("body", "reply to the diff comment").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id": 1130, "type": "DiffNote", "body": "reply to the diff comment", "author": {"username": "jane_doe"}}`)
+
+	input := &scm.ReviewInput{
+		Body:      "reply to the diff comment",
+		InReplyTo: 1128,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if want := "87805b7c09016a7058e91bdbe7b29d1f284a39e6"; got.ID != 1130 || got.Thread != want {
+		t.Errorf("Want reply 1130 in thread %s, got %d in thread %s", want, got.ID, got.Thread)
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	service := new(reviewService)
-	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/merge_requests/1/notes/1129").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.Delete(context.Background(), "diaspora/diaspora", 1, 1129)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6").
+		MatchParam("resolved", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.Resolve(context.Background(), "diaspora/diaspora", 1, "87805b7c09016a7058e91bdbe7b29d1f284a39e6")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/5/approve").
+		MatchParam("sha", "6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	input := &scm.ReviewSubmitInput{
+		State: scm.ReviewStateApproved,
+		Sha:   "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Submit(context.Background(), "diaspora/diaspora", 5, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.State != scm.ReviewStateApproved {
+		t.Errorf("Want approved review state, got %s", got.State)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewSubmit_RequestChanges(t *testing.T) {
+	client := NewDefault()
+	input := &scm.ReviewSubmitInput{State: scm.ReviewStateChangesRequested}
+	_, _, err := client.Reviews.Submit(context.Background(), "diaspora/diaspora", 5, input)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListSubmissions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListSubmissions(context.Background(), "diaspora/diaspora", 5, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewSubmission{}
+	raw, _ := os.ReadFile("testdata/approvals.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/mr_reviewers.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListReviewers(context.Background(), "diaspora/diaspora", 5, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/reviewers.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "jane").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id":5,"username":"jane","name":"Jane"}]`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/mr_reviewers.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/5").
+		MatchParam("reviewer_ids", "^1,3,5$").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.RequestReviewers(context.Background(), "diaspora/diaspora", 5, []string{"jane"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/mr_reviewers.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/5").
+		MatchParam("reviewer_ids", "^3$").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.RemoveReviewers(context.Background(), "diaspora/diaspora", 5, []string{"john_smith"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	out := []*blob{}
+	res, err := s.client.do(ctx, "GET", searchPath("blobs", opts), nil, &out)
+	return convertBlobList(out, opts.Repo), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", searchPath("projects", opts), nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.SearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	out := []*issue{}
+	res, err := s.client.do(ctx, "GET", searchPath("issues", opts), nil, &out)
+	return convertIssueList(out), res, err
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchOptions) ([]*scm.Commit, *scm.Response, error) {
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", searchPath("commits", opts), nil, &out)
+	return convertCommitList(out), res, err
+}
+
+// helper function returns the search endpoint for the
+// project, group or instance, depending on the scope of
+// the search.
+func searchPath(scope string, opts scm.SearchOptions) string {
+	switch {
+	case opts.Repo != "":
+		return fmt.Sprintf("api/v4/projects/%s/search?%s", encode(opts.Repo), encodeSearchOptions(scope, opts))
+	case opts.Namespace != "":
+		return fmt.Sprintf("api/v4/groups/%s/search?%s", encode(opts.Namespace), encodeSearchOptions(scope, opts))
+	default:
+		return fmt.Sprintf("api/v4/search?%s", encodeSearchOptions(scope, opts))
+	}
+}
+
+type blob struct {
+	Basename  string `json:"basename"`
+	Data      string `json:"data"`
+	Path      string `json:"path"`
+	Filename  string `json:"filename"`
+	Ref       string `json:"ref"`
+	Startline int    `json:"startline"`
+	ProjectID int    `json:"project_id"`
+}
+
+func convertBlobList(from []*blob, repo string) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertBlob(v, repo))
+	}
+	return to
+}
+
+// helper function converts the blob search result. The
+// result only includes the project id, so the repository
+// name is only known when the search is scoped to a project.
+func convertBlob(from *blob, repo string) *scm.CodeResult {
+	return &scm.CodeResult{
+		Repo:   repo,
+		RepoID: strconv.Itoa(from.ProjectID),
+		Path:   from.Path,
+		Ref:    from.Ref,
+		Fragments: []*scm.CodeFragment{
+			{
+				Line:    from.Startline,
+				Content: strings.TrimSuffix(from.Data, "\n"),
+			},
+		},
+	}
+}
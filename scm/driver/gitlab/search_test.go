@@ -0,0 +1,147 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/search").
+		MatchParam("scope", "blobs").
+		MatchParam("search", "github.com/pkg/errors").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_blobs.json")
+
+	opts := scm.SearchOptions{
+		Query: "github.com/pkg/errors",
+		Repo:  "diaspora/diaspora",
+		Page:  1,
+		Size:  30,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := os.ReadFile("testdata/search_blobs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/search").
+		MatchParam("scope", "projects").
+		MatchParam("search", "diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_projects.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Repositories(context.Background(), scm.SearchOptions{Query: "diaspora"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := os.ReadFile("testdata/search_projects.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/diaspora/search").
+		MatchParam("scope", "issues").
+		MatchParam("search", "crash").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Issues(context.Background(), scm.SearchOptions{Query: "crash", Namespace: "diaspora"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := os.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/gitlab-org/gitlab-test/search").
+		MatchParam("scope", "commits").
+		MatchParam("search", "fix").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commits.json")
+
+	client := NewDefault()
+	got, _, err := client.Search.Commits(context.Background(), scm.SearchOptions{Query: "fix", Repo: "gitlab-org/gitlab-test"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
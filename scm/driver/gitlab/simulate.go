@@ -0,0 +1,252 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload and headers that gitlab
+// sends, which can be used to test webhook handlers without
+// a connection to gitlab. Gitlab does not sign the payload,
+// and instead sends the secret token in the request header.
+// ErrNotSupported is returned for other hook types and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event = "Push Hook"
+		if scm.IsTag(v.Ref) {
+			event = "Tag Push Hook"
+		}
+		payload = simulatePushHook(v)
+	case *scm.PullRequestHook:
+		action, ok := simulateAction(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		event, payload = "Merge Request Hook", simulatePullRequestHook(v, action)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GitLab")
+	req.Header.Set("X-Gitlab-Event", event)
+	if guid := hook.Meta().GUID; guid != "" {
+		req.Header.Set("X-Gitlab-Event-UUID", guid)
+	}
+	if secret != "" {
+		req.Header.Set("X-Gitlab-Token", secret)
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) map[string]interface{} {
+	kind := "push"
+	if scm.IsTag(src.Ref) {
+		kind = "tag_push"
+	}
+	commits := []interface{}{}
+	for _, c := range src.Commits {
+		commits = append(commits, map[string]interface{}{
+			"id":        c.Sha,
+			"message":   c.Message,
+			"timestamp": c.Author.Date,
+			"url":       c.Link,
+			"author": map[string]interface{}{
+				"name":  c.Author.Name,
+				"email": c.Author.Email,
+			},
+			"added":    []string{},
+			"modified": []string{},
+			"removed":  []string{},
+		})
+	}
+	id, _ := strconv.Atoi(src.Sender.ID)
+	project := simulateProject(&src.Repo)
+	return map[string]interface{}{
+		"object_kind":         kind,
+		"event_name":          kind,
+		"before":              src.Before,
+		"after":               src.After,
+		"ref":                 src.Ref,
+		"checkout_sha":        src.Commit.Sha,
+		"message":             nil,
+		"user_id":             id,
+		"user_name":           src.Sender.Name,
+		"user_username":       src.Sender.Login,
+		"user_email":          src.Sender.Email,
+		"user_avatar":         src.Sender.Avatar,
+		"project_id":          project["id"],
+		"project":             project,
+		"commits":             commits,
+		"total_commits_count": len(commits),
+		"repository": map[string]interface{}{
+			"name":             src.Repo.Name,
+			"url":              src.Repo.CloneSSH,
+			"description":      src.Repo.Description,
+			"homepage":         src.Repo.Link,
+			"git_http_url":     src.Repo.Clone,
+			"git_ssh_url":      src.Repo.CloneSSH,
+			"visibility_level": project["visibility_level"],
+		},
+	}
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, action string) map[string]interface{} {
+	pr := &src.PullRequest
+	state := "opened"
+	switch {
+	case pr.Merged || src.Action == scm.ActionMerge:
+		state = "merged"
+	case pr.Closed || src.Action == scm.ActionClose:
+		state = "closed"
+	}
+	project := simulateProject(&src.Repo)
+	source := project
+	if pr.Fork != "" {
+		source = simulateProject(&scm.Repository{})
+		source["namespace"], source["name"] = scm.Split(pr.Fork)
+		source["path_with_namespace"] = pr.Fork
+	}
+	labels := []interface{}{}
+	for _, label := range pr.Labels {
+		labels = append(labels, map[string]interface{}{
+			"title":       label.Name,
+			"color":       label.Color,
+			"description": label.Description,
+		})
+	}
+	changes := map[string]interface{}{}
+	if src.Action == scm.ActionReviewReady {
+		changes["draft"] = map[string]interface{}{
+			"previous": false,
+			"current":  true,
+		}
+	}
+	return map[string]interface{}{
+		"object_kind": "merge_request",
+		"event_type":  "merge_request",
+		"user":        simulateUser(&src.Sender),
+		"project":     project,
+		"object_attributes": map[string]interface{}{
+			"iid":           pr.Number,
+			"title":         pr.Title,
+			"description":   pr.Body,
+			"source_branch": pr.Source,
+			"target_branch": pr.Target,
+			"state":         state,
+			"action":        action,
+			"url":           pr.Link,
+			"created_at":    simulateTime(pr.Created),
+			"updated_at":    simulateTime(pr.Updated),
+			"source":        source,
+			"target":        project,
+			"last_commit": map[string]interface{}{
+				"id": pr.Sha,
+			},
+		},
+		"labels":  labels,
+		"changes": changes,
+		"repository": map[string]interface{}{
+			"name":        src.Repo.Name,
+			"url":         src.Repo.CloneSSH,
+			"description": src.Repo.Description,
+			"homepage":    src.Repo.Link,
+		},
+	}
+}
+
+func simulateProject(src *scm.Repository) map[string]interface{} {
+	id, _ := strconv.Atoi(src.ID)
+	return map[string]interface{}{
+		"id":                  id,
+		"name":                src.Name,
+		"description":         src.Description,
+		"web_url":             src.Link,
+		"avatar_url":          nil,
+		"git_ssh_url":         src.CloneSSH,
+		"git_http_url":        src.Clone,
+		"namespace":           src.Namespace,
+		"visibility_level":    simulateVisibilityLevel(src),
+		"path_with_namespace": scm.Join(src.Namespace, src.Name),
+		"default_branch":      src.Branch,
+		"homepage":            src.Link,
+		"url":                 src.CloneSSH,
+		"ssh_url":             src.CloneSSH,
+		"http_url":            src.Clone,
+	}
+}
+
+func simulateUser(src *scm.User) map[string]interface{} {
+	id, _ := strconv.Atoi(src.ID)
+	return map[string]interface{}{
+		"id":         id,
+		"name":       src.Name,
+		"username":   src.Login,
+		"avatar_url": src.Avatar,
+		"email":      src.Email,
+	}
+}
+
+// helper function returns the native visibility level,
+// where 0 is private, 10 is internal and 20 is public.
+func simulateVisibilityLevel(src *scm.Repository) int {
+	switch {
+	case src.Visibility == scm.VisibilityPublic:
+		return 20
+	case src.Visibility == scm.VisibilityInternal:
+		return 10
+	case src.Visibility == scm.VisibilityPrivate, src.Private:
+		return 0
+	default:
+		return 20
+	}
+}
+
+// helper function returns the time in the native gitlab
+// webhook format, eg 2017-12-10 17:01:11 UTC.
+func simulateTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05 MST")
+}
+
+// helper function returns the native merge request action.
+func simulateAction(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "open", true
+	case scm.ActionClose:
+		return "close", true
+	case scm.ActionReopen:
+		return "reopen", true
+	case scm.ActionMerge:
+		return "merge", true
+	case scm.ActionSync, scm.ActionUpdate, scm.ActionReviewReady:
+		return "update", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "4861503",
+	Namespace: "gitlab-org",
+	Name:      "hello-world",
+	Branch:    "master",
+	Clone:     "https://gitlab.com/gitlab-org/hello-world.git",
+	CloneSSH:  "git@gitlab.com:gitlab-org/hello-world.git",
+	Link:      "https://gitlab.com/gitlab-org/hello-world",
+}
+
+var simulateSender = scm.User{
+	Login:  "sytses",
+	Name:   "Sid Sijbrandij",
+	Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Name:  "Sid Sijbrandij",
+		Email: "noreply@gitlab.com",
+		Date:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	pusher := scm.Signature{
+		Login:  "sytses",
+		Name:   "Sid Sijbrandij",
+		Email:  "noreply@gitlab.com",
+		Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87",
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "2adc9465c4edfc33834e173fe89436a7cb899a1d",
+			After:  "c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+			Commit: scm.Commit{
+				Sha:       "c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+				Message:   "update readme",
+				Link:      "https://gitlab.com/gitlab-org/hello-world/commit/c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+				Author:    pusher,
+				Committer: pusher,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+					Message:   "update readme",
+					Link:      "https://gitlab.com/gitlab-org/hello-world/commit/c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo: simulateRepo,
+			Sender: scm.User{
+				Login:  "sytses",
+				Name:   "Sid Sijbrandij",
+				Email:  "noreply@gitlab.com",
+				Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87",
+			},
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Body:   "adding build instructions to readme",
+				Sha:    "c4c79227ed610f1151f05bbc5be33b4f340d39c8",
+				Ref:    "refs/merge-requests/1/head",
+				Source: "feature",
+				Target: "master",
+				Fork:   "gitlab-org/hello-world",
+				Link:   "https://gitlab.com/gitlab-org/hello-world/merge_requests/1",
+				Closed: true,
+				Merged: true,
+				Author: simulateSender,
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
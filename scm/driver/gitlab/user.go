@@ -7,7 +7,10 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -48,6 +51,55 @@ func (s *userService) ListEmail(ctx context.Context, opts scm.ListOptions) ([]*s
 	return convertEmailList(out), res, err
 }
 
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v4/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/keys/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+// helper function returns the user ids of the user logins.
+// Gitlab references users by numeric user id, which cannot
+// be derived from the login.
+func lookupUserIDs(ctx context.Context, client *wrapper, logins []string) ([]string, *scm.Response, error) {
+	var ids []string
+	for _, login := range logins {
+		path := fmt.Sprintf("api/v4/users?username=%s", url.QueryEscape(login))
+		out := []*user{}
+		res, err := client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		if len(out) == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, strconv.Itoa(out[0].ID))
+	}
+	return ids, nil, nil
+}
+
 type user struct {
 	ID       int         `json:"id"`
 	Username string      `json:"username"`
@@ -61,6 +113,24 @@ type email struct {
 	Confirmed null.String `json:"confirmed_at"`
 }
 
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+type gpgKey struct {
+	ID      int       `json:"id"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
 // helper function to convert from the gitlab user structure to
 // the common user structure.
 func convertUser(from *user) *scm.User {
@@ -90,3 +160,45 @@ func convertEmail(from *email) *scm.Email {
 		Verified: !from.Confirmed.IsZero(),
 	}
 }
+
+// helper function to convert from the gitlab key list to
+// the common key structure.
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+// helper function to convert from the gitlab key structure to
+// the common key structure.
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      strconv.Itoa(from.ID),
+		Title:   from.Title,
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
+
+// helper function to convert from the gitlab gpg key list to
+// the common gpg key structure.
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+// helper function to convert from the gitlab gpg key structure
+// to the common gpg key structure. Gitlab only returns the
+// armored public key.
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	return &scm.GPGKey{
+		ID:      strconv.Itoa(from.ID),
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -101,6 +102,27 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("ref_name", opts.Ref)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -116,6 +138,24 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Open {
 		params.Set("state", "opened")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee_username", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("author_username", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("updated_after", opts.Since.Format(time.RFC3339))
+	}
+	switch opts.Sort {
+	case scm.IssueSortCreated:
+		params.Set("order_by", "created_at")
+	case scm.IssueSortUpdated:
+		params.Set("order_by", "updated_at")
+	}
 	return params.Encode()
 }
 
@@ -152,3 +192,30 @@ func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	return params.Encode()
+}
+
+func encodeSearchOptions(scope string, opts scm.SearchOptions) string {
+	params := url.Values{}
+	params.Set("scope", scope)
+	params.Set("search", opts.Query)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
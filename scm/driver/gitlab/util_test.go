@@ -6,6 +6,7 @@ package gitlab
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -90,6 +91,21 @@ func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Labels:   []string{"bug", "critical"},
+		Assignee: "jsmith",
+		Author:   "root",
+		Since:    time.Date(2016, 1, 4, 15, 31, 51, 0, time.UTC),
+		Sort:     scm.IssueSortCreated,
+	}
+	want := "assignee_username=jsmith&author_username=root&labels=bug%2Ccritical&order_by=created_at&updated_after=2016-01-04T15%3A31%3A51Z"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
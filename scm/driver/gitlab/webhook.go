@@ -5,11 +5,13 @@
 package gitlab
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -29,21 +31,53 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Gitlab-Event") {
+	event := req.Header.Get("X-Gitlab-Event")
+	switch event {
 	case "Push Hook", "Tag Push Hook":
 		hook, err = parsePushHook(data)
-	case "Issue Hook":
-		return nil, scm.ErrUnknownEvent
 	case "Merge Request Hook":
 		hook, err = parsePullRequestHook(data)
 	case "Note Hook":
 		hook, err = parseIssueCommentHook(data)
+	case "Pipeline Hook":
+		hook, err = parsePipelineHook(data)
+	case "Job Hook":
+		hook, err = parseJobHook(data)
+	case "Release Hook":
+		hook, err = parseReleaseHook(data)
+	case "Deployment Hook":
+		hook, err = parseDeploymentHook(data)
+	case "Wiki Page Hook":
+		hook, err = parseWikiHook(data)
+	case "Feature Flag Hook":
+		hook, err = parseFeatureFlagHook(data)
+	case "System Hook":
+		hook, err = parseSystemHook(data)
+	case "Subgroup Hook", "Member Hook", "Project Hook":
+		// group hooks deliver subgroup, member and project
+		// events using the system hook payload.
+		hook, err = parseSystemHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverGitlab,
+		GUID:     req.Header.Get("X-Gitlab-Event-UUID"),
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	// get the gitlab shared token to verify the payload
 	// authenticity. If no key is provided, no validation
@@ -55,7 +89,9 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		return hook, nil
 	}
 
-	if token != req.Header.Get("X-Gitlab-Token") {
+	// compare the tokens in constant time to prevent
+	// leaking the token through timing attacks.
+	if subtle.ConstantTimeCompare([]byte(token), []byte(req.Header.Get("X-Gitlab-Token"))) != 1 {
 		return hook, scm.ErrSignatureInvalid
 	}
 
@@ -119,6 +155,81 @@ func parsePullRequestHook(data []byte) (scm.Webhook, error) {
 	}
 }
 
+func parsePipelineHook(data []byte) (scm.Webhook, error) {
+	src := new(pipelineHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertPipelineHook(src), nil
+}
+
+func parseJobHook(data []byte) (scm.Webhook, error) {
+	src := new(jobHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertJobHook(src), nil
+}
+
+func parseReleaseHook(data []byte) (scm.Webhook, error) {
+	src := new(releaseHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertReleaseHook(src), nil
+}
+
+func parseDeploymentHook(data []byte) (scm.Webhook, error) {
+	src := new(deploymentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertDeploymentHook(src), nil
+}
+
+func parseWikiHook(data []byte) (scm.Webhook, error) {
+	src := new(wikiHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertWikiHook(src), nil
+}
+
+func parseFeatureFlagHook(data []byte) (scm.Webhook, error) {
+	src := new(featureFlagHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertFeatureFlagHook(src), nil
+}
+
+func parseSystemHook(data []byte) (scm.Webhook, error) {
+	src := new(systemHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	// system hooks deliver push, tag push and merge
+	// request events using the same payload as the
+	// project hooks.
+	switch src.ObjectKind {
+	case "push", "tag_push":
+		return parsePushHook(data)
+	case "merge_request":
+		return parsePullRequestHook(data)
+	}
+	if src.EventName == "" {
+		return nil, scm.ErrUnknownEvent
+	}
+	return convertSystemHook(src), nil
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
 	var commits []scm.Commit
 	for _, c := range src.Commits {
@@ -388,14 +499,383 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertPipelineHook(src *pipelineHook) *scm.WorkflowRunHook {
+	state := convertPipelineState(src.ObjectAttributes.Status)
+	return &scm.WorkflowRunHook{
+		Action: convertPipelineAction(state),
+		Repo:   convertHookProject(&src.Project),
+		WorkflowRun: scm.WorkflowRun{
+			ID:      src.ObjectAttributes.ID,
+			Name:    src.ObjectAttributes.Name,
+			Number:  src.ObjectAttributes.Iid,
+			Event:   src.ObjectAttributes.Source,
+			Sha:     src.ObjectAttributes.Sha,
+			Branch:  src.ObjectAttributes.Ref,
+			State:   state,
+			Link:    src.ObjectAttributes.URL,
+			Created: parseTimeString(src.ObjectAttributes.CreatedAt),
+			Updated: parseTimeString(src.ObjectAttributes.FinishedAt),
+		},
+		Sender: *convertUser(&src.User),
+	}
+}
+
+func convertJobHook(src *jobHook) *scm.CheckRunHook {
+	state := convertPipelineState(src.BuildStatus)
+	return &scm.CheckRunHook{
+		Action: convertPipelineAction(state),
+		Repo:   convertHookProject(&src.Project),
+		CheckRun: scm.CheckRun{
+			ID:        src.BuildID,
+			Name:      src.BuildName,
+			Sha:       src.Sha,
+			State:     state,
+			Link:      fmt.Sprintf("%s/-/jobs/%d", src.Project.WebURL, src.BuildID),
+			Started:   parseTimeString(src.BuildStartedAt.String),
+			Completed: parseTimeString(src.BuildFinishedAt.String),
+		},
+		Sender: *convertUser(&src.User),
+	}
+}
+
+func convertReleaseHook(src *releaseHook) *scm.ReleaseHook {
+	dst := &scm.ReleaseHook{
+		Release: scm.Release{
+			ID:          src.ID,
+			Title:       src.Name,
+			Description: src.Description,
+			Link:        src.URL,
+			Tag:         src.Tag,
+			Commitish:   src.Commit.ID,
+			Created:     parseTimeString(src.CreatedAt),
+			Published:   parseTimeString(src.ReleasedAt),
+		},
+		Repo: convertHookProject(&src.Project),
+	}
+	switch src.Action {
+	case "create":
+		dst.Action = scm.ActionCreate
+	case "update":
+		dst.Action = scm.ActionUpdate
+	case "delete":
+		dst.Action = scm.ActionDelete
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst
+}
+
+func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
+	// the deployment payload only includes the abbreviated
+	// commit sha. The full sha is extracted from the commit
+	// url if available.
+	sha := src.ShortSha
+	if i := strings.LastIndex(src.CommitURL, "/"); i != -1 {
+		sha = src.CommitURL[i+1:]
+	}
+	return &scm.DeployHook{
+		Number: src.DeploymentID,
+		Desc:   src.CommitTitle,
+		Ref: scm.Reference{
+			Name: src.Ref,
+			Sha:  sha,
+		},
+		Repo:      convertHookProject(&src.Project),
+		Sender:    *convertUser(&src.User),
+		State:     convertDeployState(src.Status),
+		Target:    src.Environment,
+		TargetURL: src.EnvironmentExternalURL,
+		Task:      "deploy",
+	}
+}
+
+func convertWikiHook(src *wikiHook) *scm.WikiHook {
+	dst := &scm.WikiHook{
+		Repo: convertHookProject(&src.Project),
+		Page: scm.WikiPage{
+			Title:   src.ObjectAttributes.Title,
+			Slug:    src.ObjectAttributes.Slug,
+			Content: src.ObjectAttributes.Content,
+			Message: src.ObjectAttributes.Message,
+			Link:    src.ObjectAttributes.URL,
+		},
+		Sender: *convertUser(&src.User),
+	}
+	switch src.ObjectAttributes.Action {
+	case "create":
+		dst.Action = scm.ActionCreate
+	case "update":
+		dst.Action = scm.ActionUpdate
+	case "delete":
+		dst.Action = scm.ActionDelete
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst
+}
+
+func convertFeatureFlagHook(src *featureFlagHook) *scm.FeatureFlagHook {
+	return &scm.FeatureFlagHook{
+		// gitlab only sends feature flag events when the
+		// flag is toggled.
+		Action: scm.ActionUpdate,
+		Repo:   convertHookProject(&src.Project),
+		FeatureFlag: scm.FeatureFlag{
+			ID:     src.ObjectAttributes.ID,
+			Name:   src.ObjectAttributes.Name,
+			Desc:   src.ObjectAttributes.Description,
+			Active: src.ObjectAttributes.Active,
+		},
+		Sender: *convertUser(&src.User),
+	}
+}
+
+func convertSystemHook(src *systemHook) *scm.SystemHook {
+	dst := &scm.SystemHook{
+		Event:  src.EventName,
+		Action: convertSystemAction(src.EventName),
+	}
+	switch {
+	case src.Project != nil:
+		// repository events include the project details
+		// and the user that triggered the event.
+		dst.Repo = convertHookProject(src.Project)
+		dst.Repo.ID = strconv.Itoa(src.ProjectID)
+		dst.Sender = scm.User{
+			Name:   src.UserName,
+			Email:  src.UserEmail,
+			Avatar: src.UserAvatar,
+		}
+	case src.ProjectID != 0:
+		// project events include the project path and
+		// the project owner.
+		namespace, name := scm.Split(src.PathWithNamespace)
+		dst.Repo = scm.Repository{
+			ID:        strconv.Itoa(src.ProjectID),
+			Namespace: namespace,
+			Name:      name,
+			Private:   convertPrivate(src.ProjectVisibility),
+		}
+		dst.Sender = scm.User{
+			Name:  src.OwnerName,
+			Email: src.OwnerEmail,
+		}
+	}
+	return dst
+}
+
+func convertHookProject(src *hookProject) scm.Repository {
+	namespace, name := scm.Split(src.PathWithNamespace)
+	return scm.Repository{
+		ID:        strconv.Itoa(src.ID),
+		Namespace: namespace,
+		Name:      name,
+		Clone:     src.GitHTTPURL,
+		CloneSSH:  src.GitSSHURL,
+		Link:      src.WebURL,
+		Branch:    src.DefaultBranch,
+		Private:   src.VisibilityLevel != 20,
+	}
+}
+
+// helper function converts the pipeline and job status to
+// the common state.
+func convertPipelineState(from string) scm.State {
+	switch from {
+	case "created", "waiting_for_resource", "preparing", "scheduled", "manual":
+		return scm.StatePending
+	default:
+		return convertState(from)
+	}
+}
+
+// helper function derives the action from the pipeline and
+// job state, since gitlab does not include an action in the
+// payload.
+func convertPipelineAction(from scm.State) scm.Action {
+	switch from {
+	case scm.StatePending:
+		return scm.ActionRequest
+	case scm.StateRunning:
+		return scm.ActionStart
+	case scm.StateSuccess, scm.StateFailure, scm.StateCanceled:
+		return scm.ActionComplete
+	default:
+		return scm.ActionUnknown
+	}
+}
+
+// helper function derives the action from the system hook
+// event name, eg project_create or user_destroy.
+func convertSystemAction(from string) scm.Action {
+	switch {
+	case strings.HasSuffix(from, "_create"),
+		strings.HasPrefix(from, "user_add_to_"):
+		return scm.ActionCreate
+	case strings.HasSuffix(from, "_destroy"),
+		strings.HasPrefix(from, "user_remove_from_"):
+		return scm.ActionDelete
+	case strings.HasSuffix(from, "_update"),
+		strings.HasPrefix(from, "user_update_for_"),
+		strings.HasSuffix(from, "_rename"),
+		strings.HasSuffix(from, "_transfer"):
+		return scm.ActionUpdate
+	default:
+		return scm.ActionUnknown
+	}
+}
+
 func parseTimeString(timeString string) time.Time {
 	layout := "2006-01-02 15:04:05 UTC"
 	// Returns zero value of time in case of an error 0001-01-01 00:00:00 +0000 UTC
-	t, _ := time.Parse(layout, timeString)
+	t, err := time.Parse(layout, timeString)
+	if err != nil {
+		// newer payloads use the rfc3339 format.
+		t, _ = time.Parse(time.RFC3339, timeString)
+	}
 	return t
 }
 
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Project != nil {
+		dst.Repo = convertHookProject(src.Project)
+	}
+	return dst, nil
+}
+
 type (
+	// gitlab webhook payload for unrecognized events
+	rawHook struct {
+		Project *hookProject `json:"project"`
+	}
+	// gitlab project object included in the pipeline, job,
+	// release, deployment, wiki and feature flag payloads.
+	hookProject struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		WebURL            string `json:"web_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		GitHTTPURL        string `json:"git_http_url"`
+		Namespace         string `json:"namespace"`
+		VisibilityLevel   int    `json:"visibility_level"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+	}
+
+	pipelineHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			ID         int64  `json:"id"`
+			Iid        int    `json:"iid"`
+			Name       string `json:"name"`
+			Ref        string `json:"ref"`
+			Tag        bool   `json:"tag"`
+			Sha        string `json:"sha"`
+			Source     string `json:"source"`
+			Status     string `json:"status"`
+			URL        string `json:"url"`
+			CreatedAt  string `json:"created_at"`
+			FinishedAt string `json:"finished_at"`
+		} `json:"object_attributes"`
+		User    user        `json:"user"`
+		Project hookProject `json:"project"`
+	}
+
+	jobHook struct {
+		ObjectKind      string      `json:"object_kind"`
+		Ref             string      `json:"ref"`
+		Tag             bool        `json:"tag"`
+		Sha             string      `json:"sha"`
+		BuildID         int64       `json:"build_id"`
+		BuildName       string      `json:"build_name"`
+		BuildStage      string      `json:"build_stage"`
+		BuildStatus     string      `json:"build_status"`
+		BuildStartedAt  null.String `json:"build_started_at"`
+		BuildFinishedAt null.String `json:"build_finished_at"`
+		PipelineID      int64       `json:"pipeline_id"`
+		User            user        `json:"user"`
+		Project         hookProject `json:"project"`
+	}
+
+	releaseHook struct {
+		ObjectKind  string      `json:"object_kind"`
+		ID          int         `json:"id"`
+		Action      string      `json:"action"`
+		Name        string      `json:"name"`
+		Tag         string      `json:"tag"`
+		Description string      `json:"description"`
+		URL         string      `json:"url"`
+		CreatedAt   string      `json:"created_at"`
+		ReleasedAt  string      `json:"released_at"`
+		Project     hookProject `json:"project"`
+		Commit      struct {
+			ID string `json:"id"`
+		} `json:"commit"`
+	}
+
+	deploymentHook struct {
+		ObjectKind             string      `json:"object_kind"`
+		Status                 string      `json:"status"`
+		DeploymentID           int64       `json:"deployment_id"`
+		Environment            string      `json:"environment"`
+		EnvironmentExternalURL string      `json:"environment_external_url"`
+		ShortSha               string      `json:"short_sha"`
+		CommitURL              string      `json:"commit_url"`
+		CommitTitle            string      `json:"commit_title"`
+		Ref                    string      `json:"ref"`
+		User                   user        `json:"user"`
+		Project                hookProject `json:"project"`
+	}
+
+	wikiHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			Title   string `json:"title"`
+			Content string `json:"content"`
+			Message string `json:"message"`
+			Slug    string `json:"slug"`
+			URL     string `json:"url"`
+			Action  string `json:"action"`
+		} `json:"object_attributes"`
+		User    user        `json:"user"`
+		Project hookProject `json:"project"`
+	}
+
+	featureFlagHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Active      bool   `json:"active"`
+		} `json:"object_attributes"`
+		User    user        `json:"user"`
+		Project hookProject `json:"project"`
+	}
+
+	systemHook struct {
+		ObjectKind        string       `json:"object_kind"`
+		EventName         string       `json:"event_name"`
+		ProjectID         int          `json:"project_id"`
+		PathWithNamespace string       `json:"path_with_namespace"`
+		ProjectVisibility string       `json:"project_visibility"`
+		OwnerName         string       `json:"owner_name"`
+		OwnerEmail        string       `json:"owner_email"`
+		UserName          string       `json:"user_name"`
+		UserEmail         string       `json:"user_email"`
+		UserAvatar        string       `json:"user_avatar"`
+		Project           *hookProject `json:"project"`
+	}
+
 	pushHook struct {
 		ObjectKind   string      `json:"object_kind"`
 		EventName    string      `json:"event_name"`
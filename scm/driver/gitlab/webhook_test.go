@@ -15,6 +15,7 @@ import (
 	"github.com/drone/go-scm/scm"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -121,6 +122,85 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/merge_request_comment_create.json.golden",
 			obj:    new(scm.IssueCommentHook),
 		},
+		// pipeline and job hooks
+		{
+			event:  "Pipeline Hook",
+			before: "testdata/webhooks/pipeline_success.json",
+			after:  "testdata/webhooks/pipeline_success.json.golden",
+			obj:    new(scm.WorkflowRunHook),
+		},
+		{
+			event:  "Job Hook",
+			before: "testdata/webhooks/job_running.json",
+			after:  "testdata/webhooks/job_running.json.golden",
+			obj:    new(scm.CheckRunHook),
+		},
+		// release hooks
+		{
+			event:  "Release Hook",
+			before: "testdata/webhooks/release_create.json",
+			after:  "testdata/webhooks/release_create.json.golden",
+			obj:    new(scm.ReleaseHook),
+		},
+		// deployment hooks
+		{
+			event:  "Deployment Hook",
+			before: "testdata/webhooks/deployment_success.json",
+			after:  "testdata/webhooks/deployment_success.json.golden",
+			obj:    new(scm.DeployHook),
+		},
+		// wiki page hooks
+		{
+			event:  "Wiki Page Hook",
+			before: "testdata/webhooks/wiki_page_create.json",
+			after:  "testdata/webhooks/wiki_page_create.json.golden",
+			obj:    new(scm.WikiHook),
+		},
+		// feature flag hooks
+		{
+			event:  "Feature Flag Hook",
+			before: "testdata/webhooks/feature_flag.json",
+			after:  "testdata/webhooks/feature_flag.json.golden",
+			obj:    new(scm.FeatureFlagHook),
+		},
+		// system hooks
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_project_create.json",
+			after:  "testdata/webhooks/system_project_create.json.golden",
+			obj:    new(scm.SystemHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/system_repository_update.json",
+			after:  "testdata/webhooks/system_repository_update.json.golden",
+			obj:    new(scm.SystemHook),
+		},
+		{
+			event:  "System Hook",
+			before: "testdata/webhooks/push.json",
+			after:  "testdata/webhooks/push.json.golden",
+			obj:    new(scm.PushHook),
+		},
+		// group hooks
+		{
+			event:  "Subgroup Hook",
+			before: "testdata/webhooks/group_subgroup_create.json",
+			after:  "testdata/webhooks/group_subgroup_create.json.golden",
+			obj:    new(scm.SystemHook),
+		},
+		{
+			event:  "Member Hook",
+			before: "testdata/webhooks/group_member_add.json",
+			after:  "testdata/webhooks/group_member_add.json.golden",
+			obj:    new(scm.SystemHook),
+		},
+		{
+			event:  "Project Hook",
+			before: "testdata/webhooks/group_project_create.json",
+			after:  "testdata/webhooks/group_project_create.json.golden",
+			obj:    new(scm.SystemHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -155,7 +235,7 @@ func TestWebhooks(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(test.obj, o); diff != "" {
+			if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 				t.Errorf("Error unmarshaling %s", test.before)
 				t.Log(diff)
 
@@ -223,6 +303,109 @@ func TestWebhook_SignatureMissing(t *testing.T) {
 	}
 }
 
+func TestWebhook_SystemHookSignatureInvalid(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/system_project_create.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "System Hook")
+	r.Header.Set("X-Gitlab-Token", "topsecre")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestWebhook_SystemHookUnknownEvent(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/", strings.NewReader("{}"))
+	r.Header.Set("X-Gitlab-Event", "System Hook")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Emoji Hook")
+	r.Header.Set("X-Gitlab-Event-UUID", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverGitlab; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "Emoji Hook"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "hello-world"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Emoji Hook")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Push Hook")
+	r.Header.Set("X-Gitlab-Event-UUID", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverGitlab,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "Push Hook",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
@@ -41,3 +41,7 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -86,6 +86,14 @@ func TestCommitList(t *testing.T) {
 	}
 }
 
+func TestFileCommitList(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListFileCommits(context.Background(), "gogits/gogs", "README.md", scm.FileCommitListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestChangeList(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, _, err := client.Git.ListChanges(context.Background(), "gogits/gogs", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
@@ -152,6 +160,14 @@ func TestBranchList(t *testing.T) {
 // tag sub-tests
 //
 
+func TestCommitDiffFind(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.FindCommitDiff(context.Background(), "gogits/gogs", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestTagFind(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, _, err := client.Git.FindTag(context.Background(), "gogits/gogs", "v1.0.0")
@@ -167,3 +183,19 @@ func TestTagList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestTagCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.CreateTag(context.Background(), "gogits/gogs", &scm.TagInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestBranchDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "gogits/gogs", "master")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -33,14 +33,17 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGogs
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
 	client.Milestones = &milestoneService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -42,16 +42,59 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
+	if len(input.Assignees) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: input.Milestone,
+	}
+	if len(input.Assignees) != 0 {
+		in.Assignee = input.Assignees[0]
 	}
+	if len(input.Labels) != 0 {
+		ids, res, err := s.lookupLabelIDs(ctx, repo, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Labels = ids
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	if len(input.Assignees) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	if len(input.Labels) != 0 {
+		ids, res, err := s.lookupLabelIDs(ctx, repo, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+		path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+		res, err = s.client.do(ctx, "PUT", path, &issueLabelsInput{Labels: ids}, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	in := &issueInput{
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: input.Milestone,
+	}
+	if len(input.Assignees) != 0 {
+		in.Assignee = input.Assignees[0]
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
 	in := &issueCommentInput{
@@ -68,7 +111,53 @@ func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "closed"}
+	return s.client.do(ctx, "PATCH", path, &data, nil)
+}
+
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	return s.client.do(ctx, "PATCH", path, &data, nil)
+}
+
+func (s *issueService) AddLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.lookupLabelIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	return s.client.do(ctx, "POST", path, &issueLabelsInput{Labels: ids}, nil)
+}
+
+func (s *issueService) RemoveLabel(ctx context.Context, repo string, number int, label string) (*scm.Response, error) {
+	ids, res, err := s.lookupLabelIDs(ctx, repo, []string{label})
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels/%d", repo, number, ids[0])
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// SetAssignees sets the issue assignee. Gogs issues have a
+// single assignee.
+func (s *issueService) SetAssignees(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	if len(logins) > 1 {
+		return nil, scm.ErrNotSupported
+	}
+	in := &issueAssigneeInput{}
+	if len(logins) != 0 {
+		in.Assignee = logins[0]
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) SetMilestone(ctx context.Context, repo string, number, milestone int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueMilestoneInput{Milestone: milestone}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -79,6 +168,32 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 	return nil, scm.ErrNotSupported
 }
 
+// helper function returns the label ids of the named repository
+// labels. Gogs manages issue labels by id.
+func (s *issueService) lookupLabelIDs(ctx context.Context, repo string, names []string) ([]int, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	var ids []int
+	for _, name := range names {
+		id := 0
+		for _, v := range out {
+			if v.Name == name {
+				id = v.ID
+				break
+			}
+		}
+		if id == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, id)
+	}
+	return ids, res, nil
+}
+
 //
 // native data structures
 //
@@ -104,8 +219,33 @@ type (
 
 	// gogs issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string `json:"title,omitempty"`
+		Body      string `json:"body,omitempty"`
+		Assignee  string `json:"assignee,omitempty"`
+		Milestone int    `json:"milestone,omitempty"`
+		Labels    []int  `json:"labels,omitempty"`
+	}
+
+	// gogs issue labels request object.
+	issueLabelsInput struct {
+		Labels []int `json:"labels"`
+	}
+
+	// gogs issue assignee request object.
+	issueAssigneeInput struct {
+		Assignee string `json:"assignee"`
+	}
+
+	// gogs issue milestone request object.
+	issueMilestoneInput struct {
+		Milestone int `json:"milestone"`
+	}
+
+	// gogs label response object.
+	label struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
 	}
 
 	// gogs issue comment response object.
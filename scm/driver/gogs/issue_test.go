@@ -99,14 +99,190 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 2, "name": "duplicate", "color": "cccccc"}]`)
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []int{1}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}]`)
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]interface{}{"title": "Bug found", "assignee": "gogs"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Labels:    []string{"bug"},
+		Assignees: []string{"gogs"},
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Issues.Update(context.Background(), "gogits/gogs", 1, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := os.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Close(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Reopen(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueAddLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 2, "name": "duplicate", "color": "cccccc"}]`)
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string]interface{}{"labels": []int{2, 1}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 2, "name": "duplicate", "color": "cccccc"}]`)
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.AddLabels(context.Background(), "gogits/gogs", 1, []string{"duplicate", "bug"})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueRemoveLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id": 1, "name": "bug", "color": "ee0701"}, {"id": 2, "name": "duplicate", "color": "cccccc"}]`)
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/issues/1/labels/2").
+		Reply(204)
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
+	_, err := client.Issues.RemoveLabel(context.Background(), "gogits/gogs", 1, "duplicate")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect issue label to be removed")
+	}
+}
+
+func TestIssueRemoveLabel_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[]`)
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.RemoveLabel(context.Background(), "gogits/gogs", 1, "duplicate")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestIssueSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"assignee": ""}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.SetAssignees(context.Background(), "gogits/gogs", 1, nil)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueSetAssignees_Multiple(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.SetAssignees(context.Background(), "gogits/gogs", 1, []string{"gogs", "unknwon"})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
 
+func TestIssueSetMilestone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]interface{}{"milestone": 3}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.SetMilestone(context.Background(), "gogits/gogs", 1, 3)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, err := client.Issues.Lock(context.Background(), "gogits/go-gogs-client", 1)
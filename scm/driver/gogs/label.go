@@ -0,0 +1,135 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+func (s *labelService) List(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertFromLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	existing, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, existing.ID)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertFromLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	existing, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, existing.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddToIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddLabels(ctx, repo, number, []string{name})
+}
+
+func (s *labelService) RemoveFromIssue(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.RemoveLabel(ctx, repo, number, name)
+}
+
+// AddToPullRequest adds the label to the pull request. Gogs
+// manages pull request labels through the issues api.
+func (s *labelService) AddToPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.AddToIssue(ctx, repo, number, name)
+}
+
+// RemoveFromPullRequest removes the label from the pull request.
+// Gogs manages pull request labels through the issues api.
+func (s *labelService) RemoveFromPullRequest(ctx context.Context, repo string, number int, name string) (*scm.Response, error) {
+	return s.RemoveFromIssue(ctx, repo, number, name)
+}
+
+// helper function returns the named repository label. Gogs
+// identifies labels by id.
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range out {
+		if v.Name == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+type labelInput struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:  from.Name,
+		Color: strings.TrimPrefix(from.Color, "#"),
+	}
+}
+
+// helper function converts the hex color code to the gogs
+// color format, which requires a leading hash.
+func convertFromLabelColor(from string) string {
+	if from == "" {
+		return from
+	}
+	return "#" + strings.TrimPrefix(from, "#")
+}
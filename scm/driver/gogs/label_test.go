@@ -0,0 +1,106 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.Find(context.Background(), "gogits/gogs", "duplicate")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "duplicate" || got.Color != "cccccc" {
+		t.Errorf("Unexpected label %s with color %s", got.Name, got.Color)
+	}
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.List(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := os.ReadFile("testdata/labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/labels").
+		JSON(map[string]string{"name": "bug", "color": "#ee0701"}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 1, "name": "bug", "color": "ee0701"}`)
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.Create(context.Background(), "gogits/gogs", &scm.LabelInput{Name: "bug", Color: "ee0701"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "bug" || got.Color != "ee0701" {
+		t.Errorf("Unexpected label %s with color %s", got.Name, got.Color)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/labels/2").
+		Reply(204)
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Labels.Delete(context.Background(), "gogits/gogs", "duplicate")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect label to be deleted")
+	}
+}
@@ -22,6 +22,10 @@ func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comme
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) FindDiff(context.Context, string, int) (*scm.Diff, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) List(context.Context, string, scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -54,6 +58,10 @@ func (s *pullService) Merge(context.Context, string, int) (*scm.Response, error)
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) MergeWithOptions(context.Context, string, int, *scm.MergeInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -23,6 +23,14 @@ func TestPullRequestFind(t *testing.T) {
 	}
 }
 
+func TestPullRequestFindDiff(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.PullRequests.FindDiff(context.Background(), "gogits/gogs", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullRequestList(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, _, err := client.PullRequests.List(context.Background(), "gogits/gogs", scm.PullRequestListOptions{})
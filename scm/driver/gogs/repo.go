@@ -104,6 +104,64 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// gogs does not support internal repositories or
+	// setting the default branch on create.
+	if input.Visibility == scm.VisibilityInternal || input.Branch != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "api/v1/user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("api/v1/org/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     input.Visibility == scm.VisibilityPrivate,
+		AutoInit:    input.Readme || input.Gitignore != "",
+		Gitignores:  input.Gitignore,
+	}
+	if input.Readme {
+		in.Readme = "Default"
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Update(context.Context, string, *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Archive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Unarchive(context.Context, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -126,6 +184,16 @@ type (
 		Permissions   perm      `json:"permissions"`
 	}
 
+	// gogs repository creation request.
+	repositoryInput struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+		Private     bool   `json:"private,omitempty"`
+		AutoInit    bool   `json:"auto_init,omitempty"`
+		Gitignores  string `json:"gitignores,omitempty"`
+		Readme      string `json:"readme,omitempty"`
+	}
+
 	// gogs permissions details.
 	perm struct {
 		Admin bool `json:"admin"`
@@ -230,6 +230,84 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/org/gogits/repos").
+		JSON(map[string]interface{}{
+			"name":        "gogs",
+			"description": "Gogs is a painless self-hosted Git service",
+			"auto_init":   true,
+			"readme":      "Default",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:   "gogits",
+		Name:        "gogs",
+		Description: "Gogs is a painless self-hosted Git service",
+		Readme:      true,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.Fork(context.Background(), "gogits/gogs", "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.Update(context.Background(), "gogits/gogs", &scm.RepositoryInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryArchive(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Repositories.Archive(context.Background(), "gogits/gogs")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Repositories.Delete(context.Background(), "gogits/gogs")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
@@ -0,0 +1,196 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/hmac"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that gogs sends, which can be used to test webhook
+// handlers without a connection to gogs. The payload is
+// signed if the secret is not empty. ErrNotSupported is
+// returned for other hook types and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event, payload = "push", simulatePushHook(v)
+	case *scm.PullRequestHook:
+		action, ok := simulateAction(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		event, payload = "pull_request", simulatePullRequestHook(v, action)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gogs-Event", event)
+	if guid := hook.Meta().GUID; guid != "" {
+		req.Header.Set("X-Gogs-Delivery", guid)
+	}
+	if secret != "" {
+		req.Header.Set("X-Gogs-Signature", hmac.Sign(sha256.New, data, []byte(secret)))
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook) *pushHook {
+	dst := &pushHook{
+		Ref:        src.Ref,
+		Before:     src.Before,
+		After:      src.After,
+		Compare:    src.Commit.Link,
+		Commits:    []commit{},
+		Repository: *simulateRepository(&src.Repo),
+		Pusher:     *simulateUser(&src.Sender),
+		Sender:     *simulateUser(&src.Sender),
+	}
+	if dst.After == "" {
+		dst.After = src.Commit.Sha
+	}
+	for _, c := range src.Commits {
+		dst.Commits = append(dst.Commits, commit{
+			ID:      c.Sha,
+			Message: c.Message,
+			URL:     c.Link,
+			Author: signature{
+				Name:     c.Author.Name,
+				Email:    c.Author.Email,
+				Username: c.Author.Login,
+			},
+			Committer: signature{
+				Name:     c.Committer.Name,
+				Email:    c.Committer.Email,
+				Username: c.Committer.Login,
+			},
+			Timestamp: c.Author.Date,
+		})
+	}
+	return dst
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, action string) *pullRequestHook {
+	pull := &src.PullRequest
+	state := "open"
+	if pull.Closed || pull.Merged || src.Action == scm.ActionClose || src.Action == scm.ActionMerge {
+		state = "closed"
+	}
+	repo := simulateRepository(&src.Repo)
+	head := *repo
+	if pull.Fork != "" {
+		head.Owner.Login, head.Name = scm.Split(pull.Fork)
+		head.Owner.Username = head.Owner.Login
+		head.FullName = pull.Fork
+	}
+	return &pullRequestHook{
+		Action: action,
+		Number: pull.Number,
+		PullRequest: pullRequest{
+			Number:     pull.Number,
+			User:       *simulateUser(&pull.Author),
+			Title:      pull.Title,
+			Body:       pull.Body,
+			State:      state,
+			HeadBranch: pull.Source,
+			HeadRepo:   head,
+			BaseBranch: pull.Target,
+			BaseRepo:   *repo,
+			HTMLURL:    pull.Link,
+			Merged:     pull.Merged || src.Action == scm.ActionMerge,
+		},
+		Repository: *repo,
+		Sender:     *simulateUser(&src.Sender),
+	}
+}
+
+func simulateRepository(src *scm.Repository) *repository {
+	id, _ := strconv.Atoi(src.ID)
+	dst := &repository{
+		ID: id,
+		Owner: user{
+			Login:    src.Namespace,
+			Username: src.Namespace,
+		},
+		Name:          src.Name,
+		FullName:      scm.Join(src.Namespace, src.Name),
+		Private:       src.Private,
+		HTMLURL:       src.Link,
+		SSHURL:        src.CloneSSH,
+		CloneURL:      src.Clone,
+		DefaultBranch: src.Branch,
+		CreatedAt:     src.Created,
+		UpdatedAt:     src.Updated,
+	}
+	if src.Perm != nil {
+		dst.Permissions = perm{
+			Admin: src.Perm.Admin,
+			Push:  src.Perm.Push,
+			Pull:  src.Perm.Pull,
+		}
+	}
+	return dst
+}
+
+func simulateUser(src *scm.User) *user {
+	id, _ := strconv.Atoi(src.ID)
+	return &user{
+		ID:       id,
+		Login:    src.Login,
+		Username: src.Login,
+		Fullname: src.Name,
+		Email:    src.Email,
+		Avatar:   src.Avatar,
+	}
+}
+
+// helper function returns the native pull request action.
+func simulateAction(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "opened", true
+	case scm.ActionClose, scm.ActionMerge:
+		return "closed", true
+	case scm.ActionReopen:
+		return "reopened", true
+	case scm.ActionSync:
+		return "synchronized", true
+	case scm.ActionUpdate, scm.ActionEdit:
+		return "edited", true
+	case scm.ActionLabel:
+		return "label_updated", true
+	case scm.ActionUnlabel:
+		return "label_cleared", true
+	case scm.ActionAssign:
+		return "assigned", true
+	case scm.ActionUnassign:
+		return "unassigned", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "6",
+	Namespace: "gogs",
+	Name:      "hello-world",
+	Perm:      &scm.Perm{Pull: true},
+	Branch:    "master",
+	Clone:     "https://try.gogs.io/gogs/hello-world.git",
+	CloneSSH:  "git@try.gogs.io:gogs/hello-world.git",
+	Link:      "https://try.gogs.io/gogs/hello-world",
+}
+
+var simulateSender = scm.User{
+	Login:  "gogs",
+	Name:   "Gogs",
+	Email:  "gogs@gogs.io",
+	Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Name:  "Gogs",
+		Email: "gogs@gogs.io",
+		Login: "gogs",
+		Date:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref: "refs/heads/master",
+			Commit: scm.Commit{
+				Sha:       "ef98532add3b2feb7a137426bba1248724367df5",
+				Message:   "bump\n",
+				Link:      "https://try.gogs.io/gogs/hello-world/compare/4522e1bcf8a6...ef98532add3b",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "ef98532add3b2feb7a137426bba1248724367df5",
+					Message:   "bump\n",
+					Link:      "https://try.gogs.io/gogs/hello-world/commit/ef98532add3b2feb7a137426bba1248724367df5",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionClose,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "Add License File",
+				Body:   "Using a BSD License",
+				Ref:    "refs/pull/1/head",
+				Source: "feature",
+				Target: "master",
+				Fork:   "spaceghost/hello-world",
+				Link:   "https://try.gogs.io/gogs/hello-world/pulls/1",
+				Closed: true,
+				Author: scm.User{
+					Login:  "gogs",
+					Email:  "gogs@gogs.io",
+					Avatar: "https://secure.gravatar.com/avatar/8c58a0be77ee441bb8f8595b7f1b4e87",
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{
+		Ref:     "refs/heads/master",
+		Repo:    simulateRepo,
+		Commits: []scm.Commit{{Sha: "ef98532add3b2feb7a137426bba1248724367df5"}},
+	}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
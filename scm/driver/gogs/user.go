@@ -7,6 +7,8 @@ package gogs
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -37,6 +39,31 @@ func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *userService) ListKeys(ctx context.Context, _ scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", "api/v1/user/keys", nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/keys/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(context.Context, scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -50,6 +77,22 @@ type user struct {
 	Avatar   string `json:"avatar_url"`
 }
 
+type (
+	// gogs ssh key object.
+	key struct {
+		ID      int       `json:"id"`
+		Title   string    `json:"title"`
+		Key     string    `json:"key"`
+		Created time.Time `json:"created_at"`
+	}
+
+	// gogs ssh key creation request.
+	keyInput struct {
+		Title string `json:"title"`
+		Key   string `json:"key"`
+	}
+)
+
 //
 // native data structure conversion
 //
@@ -69,3 +112,20 @@ func userLogin(src *user) string {
 	}
 	return src.Login
 }
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:      strconv.Itoa(src.ID),
+		Title:   src.Title,
+		Key:     src.Key,
+		Created: src.Created,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/hmac"
@@ -28,7 +29,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Gogs-Event") {
+	event := req.Header.Get("X-Gogs-Event")
+	switch event {
 	case "push":
 		hook, err = s.parsePushHook(data)
 	case "create":
@@ -41,12 +43,29 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseIssueCommentHook(data)
 	case "pull_request":
 		hook, err = s.parsePullRequestHook(data)
+	case "release":
+		hook, err = s.parseReleaseHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverGogs,
+		GUID:     req.Header.Get("X-Gogs-Delivery"),
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -123,11 +142,37 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return convertPullRequestHook(dst), err
 }
 
+func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
+	dst := new(releaseHook)
+	err := json.Unmarshal(data, dst)
+	return convertReleaseHook(dst), err
+}
+
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst, nil
+}
+
 //
 // native data structures
 //
 
 type (
+	// gogs webhook payload for unrecognized events
+	rawHook struct {
+		Repository *repository `json:"repository"`
+	}
 	// gogs push webhook payload
 	pushHook struct {
 		Ref        string     `json:"ref"`
@@ -167,6 +212,24 @@ type (
 		Repository  repository  `json:"repository"`
 		Sender      user        `json:"sender"`
 	}
+
+	// gogs release webhook payload
+	releaseHook struct {
+		Action  string `json:"action"`
+		Release struct {
+			ID         int       `json:"id"`
+			TagName    string    `json:"tag_name"`
+			Target     string    `json:"target_commitish"`
+			Name       string    `json:"name"`
+			Body       string    `json:"body"`
+			Draft      bool      `json:"draft"`
+			Prerelease bool      `json:"prerelease"`
+			Author     user      `json:"author"`
+			Created    time.Time `json:"created_at"`
+		} `json:"release"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
 )
 
 //
@@ -300,6 +363,26 @@ func convertIssueCommentHook(dst *issueHook) *scm.IssueCommentHook {
 	}
 }
 
+func convertReleaseHook(dst *releaseHook) *scm.ReleaseHook {
+	return &scm.ReleaseHook{
+		Action: convertAction(dst.Action),
+		Release: scm.Release{
+			ID:          dst.Release.ID,
+			Title:       dst.Release.Name,
+			Description: dst.Release.Body,
+			Link:        fmt.Sprintf("%s/releases/tag/%s", dst.Repository.HTMLURL, dst.Release.TagName),
+			Tag:         dst.Release.TagName,
+			Commitish:   dst.Release.Target,
+			Draft:       dst.Release.Draft,
+			Prerelease:  dst.Release.Prerelease,
+			Created:     dst.Release.Created,
+			Published:   dst.Release.Created,
+		},
+		Repo:   *convertRepository(&dst.Repository),
+		Sender: *convertUser(&dst.Sender),
+	}
+}
+
 func convertAction(src string) (action scm.Action) {
 	switch src {
 	case "create", "created":
@@ -322,6 +405,16 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionMerge
 	case "synchronize", "synchronized":
 		return scm.ActionSync
+	case "label_updated":
+		return scm.ActionLabel
+	case "label_cleared":
+		return scm.ActionUnlabel
+	case "assigned":
+		return scm.ActionAssign
+	case "unassigned":
+		return scm.ActionUnassign
+	case "published":
+		return scm.ActionPublish
 	default:
 		return
 	}
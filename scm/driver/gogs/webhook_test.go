@@ -15,6 +15,7 @@ import (
 	"github.com/drone/go-scm/scm"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -116,6 +117,28 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_comment_created.json.golden",
 			obj:    new(scm.PullRequestCommentHook),
 		},
+		{
+			sig:    "dd694285e367fcac9c605e681e2688177dfa3f9334abd784d6269a5c6e8f4edc",
+			event:  "pull_request",
+			before: "testdata/webhooks/pull_request_assigned.json",
+			after:  "testdata/webhooks/pull_request_assigned.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		{
+			sig:    "551161786d928961f4cafda25944fecd3ed2bd759c51315b94016276ee6ad8bb",
+			event:  "pull_request",
+			before: "testdata/webhooks/pull_request_label_updated.json",
+			after:  "testdata/webhooks/pull_request_label_updated.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// release hooks
+		{
+			sig:    "5491feae27f45ccf0f3590ec6ec6481051b0abae0c289e987b462428117c0a66",
+			event:  "release",
+			before: "testdata/webhooks/release_published.json",
+			after:  "testdata/webhooks/release_published.json.golden",
+			obj:    new(scm.ReleaseHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -150,7 +173,7 @@ func TestWebhooks(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(test.obj, o); diff != "" {
+			if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 				t.Errorf("Error unmarshaling %s", test.before)
 				t.Log(diff)
 			}
@@ -228,6 +251,85 @@ func TestWebhookMissingSignature(t *testing.T) {
 	}
 }
 
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gogs-Event", "fork")
+	r.Header.Set("X-Gogs-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverGogs; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "fork"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "hello-world"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gogs-Event", "fork")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gogs-Event", "push")
+	r.Header.Set("X-Gogs-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverGogs,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "push",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
@@ -120,6 +120,18 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 	return convertContentInfoList(out.Content.Entries), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("api/v1/repos/%s/blame/%s?git_ref=%s&%s", repoId, path, ref, queryParams)
+	out := []*blamePart{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertBlameList(out), res, err
+}
+
 type editFile struct {
 	Actions   []action `json:"actions"`
 	Branch    string   `json:"branch"`
@@ -246,3 +258,31 @@ func convertContentInfo(from fileEntry) *scm.ContentInfo {
 	}
 	return to
 }
+
+type blamePart struct {
+	Commit *commitInfo `json:"commit"`
+	Lines  []string    `json:"lines"`
+}
+
+// helper function converts the blame parts to ranges. The
+// line numbers are not included in the response, so they
+// are computed from the number of lines in each part.
+func convertBlameList(from []*blamePart) []*scm.BlameRange {
+	to := []*scm.BlameRange{}
+	line := 1
+	for _, v := range from {
+		if len(v.Lines) == 0 {
+			continue
+		}
+		blame := &scm.BlameRange{
+			StartLine: line,
+			EndLine:   line + len(v.Lines) - 1,
+		}
+		if v.Commit != nil {
+			blame.Commit = *convertCommitInfo(v.Commit)
+		}
+		to = append(to, blame)
+		line += len(v.Lines)
+	}
+	return to
+}
@@ -231,3 +231,41 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentBlame(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/blame/README.md").
+		MatchParam("git_ref", "main").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/json").
+		File("testdata/blame.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	got, _, err := client.Contents.Blame(context.Background(), harnessRepo, "README.md", "main")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.BlameRange{}
+	raw, _ := os.ReadFile("testdata/blame.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -55,6 +55,16 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommitInfo(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/commits/%s/diff?%s", repoId, ref, queryParams)
+	return s.client.diff(ctx, path)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -89,6 +99,18 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("api/v1/repos/%s/commits?%s&%s", repoId, encodeFileCommitListOptions(path, opts), queryParams)
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -117,6 +139,59 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags?%s", repoId, queryParams)
+	in := &tagInput{
+		Name:    params.Name,
+		Target:  params.Sha,
+		Message: params.Message,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTag(out), res, err
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s?%s", repoId, name, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s?%s", repoId, name, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	return false, nil, scm.ErrNotSupported
+}
+
 // native data structures
 type (
 	commits struct {
@@ -146,6 +221,27 @@ type (
 		Name   string `json:"name"`
 		Target string `json:"target"`
 	}
+	tagInput struct {
+		Name    string `json:"name"`
+		Target  string `json:"target"`
+		Message string `json:"message,omitempty"`
+	}
+	tag struct {
+		Name        string `json:"name"`
+		Sha         string `json:"sha"`
+		IsAnnotated bool   `json:"is_annotated"`
+		Message     string `json:"message"`
+		Tagger      struct {
+			Identity struct {
+				Email string `json:"email"`
+				Name  string `json:"name"`
+			} `json:"identity"`
+			When time.Time `json:"when"`
+		} `json:"tagger"`
+		Commit struct {
+			Sha string `json:"sha"`
+		} `json:"commit"`
+	}
 	branch struct {
 		Commit struct {
 			Author struct {
@@ -205,6 +301,24 @@ func convertBranch(src *branch) *scm.Reference {
 	}
 }
 
+func convertTag(src *tag) *scm.Tag {
+	dst := &scm.Tag{
+		Name: src.Name,
+		Path: scm.ExpandRef(src.Name, "refs/tags/"),
+		Sha:  src.Commit.Sha,
+	}
+	if src.IsAnnotated {
+		dst.Object = src.Sha
+		dst.Message = src.Message
+		dst.Tagger = scm.Signature{
+			Name:  src.Tagger.Identity.Name,
+			Email: src.Tagger.Identity.Email,
+			Date:  src.Tagger.When,
+		}
+	}
+	return dst
+}
+
 func convertCommitList(src *commits) []*scm.Commit {
 	var dst []*scm.Commit
 	for _, v := range src.Commits {
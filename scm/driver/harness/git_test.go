@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/transport"
@@ -65,6 +66,50 @@ func TestListCommits(t *testing.T) {
 	}
 }
 
+func TestListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/commits").
+		MatchParam("path", "README.md").
+		MatchParam("git_ref", "main").
+		MatchParam("since", "1672531200000").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	opts := scm.FileCommitListOptions{
+		Ref:   "main",
+		Since: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	got, _, err := client.Git.ListFileCommits(context.Background(), harnessRepo, "README.md", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestFindCommit(t *testing.T) {
 	if harnessPAT == "" {
 		defer gock.Off()
@@ -107,6 +152,44 @@ func TestFindCommit(t *testing.T) {
 	}
 }
 
+func TestFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/commits/e8ef0374ca0cee8048e94b28eaf0d9e2e2515a14/diff").
+		MatchHeader("Accept", "text/plain").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	got, _, err := client.Git.FindCommitDiff(context.Background(), harnessRepo, "e8ef0374ca0cee8048e94b28eaf0d9e2e2515a14")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestFindBranch(t *testing.T) {
 	if harnessPAT == "" {
 		defer gock.Off()
@@ -229,6 +312,108 @@ func TestCreateBranch(t *testing.T) {
 
 }
 
+func TestCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/tags").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(201).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	input := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "e8ef0374ca0cee8048e94b28eaf0d9e2e2515a14",
+		Message: "First release",
+	}
+	got, _, err := client.Git.CreateTag(context.Background(), harnessRepo, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := os.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/tags/v1.0.0").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	res, err := client.Git.DeleteTag(context.Background(), harnessRepo, "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/branches/test").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	res, err := client.Git.DeleteBranch(context.Background(), harnessRepo, "test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
 func TestCompareChanges(t *testing.T) {
 	source := "542ddabd47d7bfa79359b7b4e2af7f975354e35f"
 	target := "c7d0d4b21d5cfdf47475ff1f6281ef1a91883d"
@@ -32,14 +32,17 @@ func New(uri, account, organization, project string) (*scm.Client, error) {
 	client.Driver = scm.DriverHarness
 	client.Linker = &linker{base.String()}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -71,7 +74,30 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		}
 		req.Body = buf
 	}
+	return c.send(ctx, req, out)
+}
+
+// diff fetches the resource as a raw unified diff and
+// parses the response.
+func (c *wrapper) diff(ctx context.Context, path string) (*scm.Diff, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Header: map[string][]string{
+			"Accept": {"text/plain"},
+		},
+	}
+	out := new(bytes.Buffer)
+	res, err := c.send(ctx, req, out)
+	if err != nil {
+		return nil, res, err
+	}
+	diff, err := scm.ParseDiff(out.String())
+	return diff, res, err
+}
 
+// send executes the request and unmarshals the response.
+func (c *wrapper) send(ctx context.Context, req *scm.Request, out interface{}) (*scm.Response, error) {
 	// execute the http request
 	res, err := c.Client.Do(ctx, req)
 	if err != nil {
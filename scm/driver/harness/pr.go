@@ -33,6 +33,16 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, index int) (*scm.Diff, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/diff?%s", repoId, index, queryParams)
+	return s.client.diff(ctx, path)
+}
+
 func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -118,6 +128,23 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.R
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, index int, input *scm.MergeInput) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/merge?%s", repoId, index, queryParams)
+	in := &mergeInput{
+		Method:             convertFromMergeMethod(input.Method),
+		SourceSha:          input.Sha,
+		Title:              input.CommitTitle,
+		Message:            input.CommitMessage,
+		DeleteSourceBranch: input.DeleteSourceBranch,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
 func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -196,6 +223,14 @@ type (
 		Title         string `json:"title"`
 	}
 
+	mergeInput struct {
+		Method             string `json:"method"`
+		SourceSha          string `json:"source_sha,omitempty"`
+		Title              string `json:"title,omitempty"`
+		Message            string `json:"message,omitempty"`
+		DeleteSourceBranch bool   `json:"delete_source_branch,omitempty"`
+	}
+
 	commit struct {
 		Author struct {
 			Identity struct {
@@ -362,3 +397,16 @@ func convertComment(comment *prCommentResponse) *scm.Comment {
 		Updated: time.UnixMilli(comment.Updated),
 	}
 }
+
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase"
+	case scm.MergeMethodFastForward:
+		return "fast-forward"
+	default:
+		return "merge"
+	}
+}
@@ -63,6 +63,44 @@ func TestPRFind(t *testing.T) {
 	}
 }
 
+func TestPRFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/pullreq/1/diff").
+		MatchHeader("Accept", "text/plain").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+	got, _, err := client.PullRequests.FindDiff(context.Background(), harnessRepo, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPRCommits(t *testing.T) {
 	if harnessPAT == "" {
 		defer gock.Off()
@@ -191,3 +229,42 @@ func TestPRComment(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+	gock.New(gockOrigin).
+		Post(fmt.Sprintf("/gateway/code/api/v1/repos/%s/pullreq/1/merge", harnessRepo)).
+		MatchParam("accountIdentifier", harnessAccount).
+		MatchParam("orgIdentifier", harnessOrg).
+		MatchParam("projectIdentifier", harnessProject).
+		JSON(map[string]interface{}{
+			"method":               "squash",
+			"source_sha":           "e8ef0374ca0cee8048e94b28eaf0d9e2e2515be4",
+			"title":                "squashed",
+			"delete_source_branch": true,
+		}).
+		Reply(200).
+		Type("plain/text")
+
+	client, _ := New(gockOrigin, harnessAccount, harnessOrg, harnessProject)
+	client.Client = &http.Client{
+		Transport: &transport.Custom{
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", harnessPAT)
+			},
+		},
+	}
+
+	input := &scm.MergeInput{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "squashed",
+		Sha:                "e8ef0374ca0cee8048e94b28eaf0d9e2e2515be4",
+		DeleteSourceBranch: true,
+	}
+	if _, err := client.PullRequests.MergeWithOptions(context.Background(), harnessRepo, 1, input); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect merge request to be sent")
+	}
+}
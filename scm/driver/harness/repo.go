@@ -121,6 +121,82 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// repositories are created in the account, organization
+	// and project configured on the client.
+	if input.Namespace != "" || input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, input.Name)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos?%s", queryParams)
+	in := &repositoryInput{
+		Identifier:    repoId,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		IsPublic:      input.Visibility == scm.VisibilityPublic,
+		Readme:        input.Readme,
+		GitIgnore:     input.Gitignore,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// only the repository description can be updated.
+	if input.Name != "" || input.Branch != "" || input.Visibility != scm.VisibilityUndefined || input.Archived {
+		return nil, nil, scm.ErrNotSupported
+	}
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s?%s", repoId, queryParams)
+	in := &repositoryUpdateInput{Description: input.Description}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s?%s", repoId, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -146,6 +222,19 @@ type (
 		NumMergedPulls int    `json:"num_merged_pulls"`
 		GitURL         string `json:"git_url"`
 	}
+	// harness repository creation request.
+	repositoryInput struct {
+		Identifier    string `json:"identifier"`
+		Description   string `json:"description,omitempty"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+		IsPublic      bool   `json:"is_public"`
+		Readme        bool   `json:"readme,omitempty"`
+		GitIgnore     string `json:"git_ignore,omitempty"`
+	}
+	// harness repository update request.
+	repositoryUpdateInput struct {
+		Description string `json:"description"`
+	}
 	hook struct {
 		Created               int      `json:"created"`
 		CreatedBy             int      `json:"created_by"`
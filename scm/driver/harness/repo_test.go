@@ -252,3 +252,69 @@ func TestRepositoryHookCreateDelete(t *testing.T) {
 		return
 	}
 }
+
+func TestRepositoryCreate(t *testing.T) {
+	if harnessPAT != "" {
+		t.Skip("skipping repository create against a live server")
+	}
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		JSON(map[string]interface{}{
+			"identifier":     "demo",
+			"default_branch": "main",
+			"is_public":      false,
+			"readme":         true,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	input := &scm.RepositoryInput{
+		Name:       "demo",
+		Branch:     "main",
+		Visibility: scm.VisibilityPrivate,
+		Readme:     true,
+	}
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	if harnessPAT != "" {
+		t.Skip("skipping repository delete against a live server")
+	}
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/demo").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Repositories.Delete(context.Background(), "demo")
+	if err != nil {
+		t.Error(err)
+	}
+}
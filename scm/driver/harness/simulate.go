@@ -0,0 +1,199 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/hmac"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that harness sends, which can be used to test webhook
+// handlers without a connection to harness. The payload is
+// signed if the secret is not empty. ErrNotSupported is
+// returned for other hook types and actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var trigger string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		trigger = "branch_updated"
+		if scm.IsTag(v.Ref) {
+			trigger = "tag_updated"
+		}
+		payload = simulatePushHook(v, trigger)
+	case *scm.PullRequestHook:
+		var ok bool
+		trigger, ok = simulateTrigger(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		payload = simulatePullRequestHook(v, trigger)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Harness-Trigger", trigger)
+	if secret != "" {
+		req.Header.Set("X-Harness-Signature", hmac.Sign(sha256.New, data, []byte(secret)))
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook, trigger string) *pushHook {
+	after := src.After
+	if after == "" {
+		after = src.Commit.Sha
+	}
+	dst := &pushHook{
+		Trigger:           trigger,
+		Repo:              simulateRepository(&src.Repo),
+		Principal:         simulatePrincipal(&src.Sender),
+		HeadCommit:        simulateCommit(&src.Commit),
+		Sha:               after,
+		OldSha:            src.Before,
+		Commits:           []hookCommit{},
+		TotalCommitsCount: int64(len(src.Commits)),
+	}
+	dst.Ref.Name = src.Ref
+	dst.Ref.Repo = dst.Repo
+	for _, c := range src.Commits {
+		dst.Commits = append(dst.Commits, simulateCommit(&c))
+	}
+	return dst
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, trigger string) *pullRequestHook {
+	pull := &src.PullRequest
+	state := "open"
+	switch {
+	case pull.Merged || src.Action == scm.ActionMerge:
+		state = "merged"
+	case pull.Closed || src.Action == scm.ActionClose:
+		state = "closed"
+	}
+	repo := simulateRepository(&src.Repo)
+	dst := &pullRequestHook{
+		Trigger:   trigger,
+		Repo:      repo,
+		Principal: simulatePrincipal(&src.Sender),
+		PullReq: pullReq{
+			Number:       pull.Number,
+			State:        state,
+			Title:        pull.Title,
+			Description:  pull.Body,
+			SourceRepoID: repo.ID,
+			SourceBranch: pull.Source,
+			TargetRepoID: repo.ID,
+			TargetBranch: pull.Target,
+			Author:       simulatePrincipal(&pull.Author),
+			PrURL:        pull.Link,
+		},
+		Sha: pull.Sha,
+		HeadCommit: hookCommit{
+			Sha: pull.Sha,
+		},
+		Commits: []hookCommit{},
+	}
+	dst.Ref.Name = scm.ExpandRef(pull.Source, "refs/heads")
+	dst.Ref.Repo = repo
+	dst.TargetRef.Name = scm.ExpandRef(pull.Target, "refs/heads")
+	dst.TargetRef.Repo = repo
+	// the label is only included in the label assigned
+	// payload, and harness labels are key value pairs.
+	if src.Action == scm.ActionLabel && len(pull.Labels) != 0 {
+		dst.Label.Key, dst.Label.Value, _ = strings.Cut(pull.Labels[0].Name, ":")
+		dst.Label.Color = pull.Labels[0].Color
+	}
+	return dst
+}
+
+func simulateCommit(src *scm.Commit) hookCommit {
+	dst := hookCommit{
+		Sha:      src.Sha,
+		Message:  src.Message,
+		Added:    []string{},
+		Modified: []string{},
+		Removed:  []string{},
+	}
+	dst.Author.Identity.Name = src.Author.Name
+	dst.Author.Identity.Email = src.Author.Email
+	dst.Author.When = src.Author.Date.Format(time.RFC3339)
+	dst.Committer.Identity.Name = src.Committer.Name
+	dst.Committer.Identity.Email = src.Committer.Email
+	dst.Committer.When = src.Committer.Date.Format(time.RFC3339)
+	return dst
+}
+
+func simulateRepository(src *scm.Repository) repo {
+	id, _ := strconv.Atoi(src.ID)
+	return repo{
+		ID:            id,
+		Path:          scm.Join(src.Namespace, src.Name),
+		UID:           src.Name,
+		DefaultBranch: src.Branch,
+		GitURL:        src.Clone,
+	}
+}
+
+func simulatePrincipal(src *scm.User) principal {
+	dst := principal{
+		UID:         src.Login,
+		DisplayName: src.Name,
+		Email:       src.Email,
+		Type:        "user",
+	}
+	if !src.Created.IsZero() {
+		dst.Created = src.Created.UnixMilli()
+	}
+	if !src.Updated.IsZero() {
+		dst.Updated = src.Updated.UnixMilli()
+	}
+	return dst
+}
+
+// helper function returns the native pull request trigger.
+func simulateTrigger(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen, scm.ActionCreate:
+		return "pullreq_created", true
+	case scm.ActionReopen:
+		return "pullreq_reopened", true
+	case scm.ActionSync:
+		return "pullreq_branch_updated", true
+	case scm.ActionUpdate, scm.ActionEdit:
+		return "pullreq_updated", true
+	case scm.ActionClose:
+		return "pullreq_closed", true
+	case scm.ActionMerge:
+		return "pullreq_merged", true
+	case scm.ActionLabel:
+		return "pullreq_label_assigned", true
+	default:
+		return "", false
+	}
+}
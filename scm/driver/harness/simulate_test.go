@@ -0,0 +1,138 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:     "13",
+	Name:   "aba",
+	Branch: "main",
+	Clone:  "http://localhost:3000/git/kmpySmUISimoRrJL6NL73w/myOrg/myProject/aba.git",
+	Link:   "http://localhost:3000/git/kmpySmUISimoRrJL6NL73w/myOrg/myProject/aba.git",
+}
+
+var simulateSender = scm.User{
+	ID:      "0osgWsTZRsSZ8RWfjLRkEg",
+	Login:   "0osgWsTZRsSZ8RWfjLRkEg",
+	Name:    "default",
+	Email:   "default@harness.io",
+	Created: time.UnixMilli(1675390885380),
+	Updated: time.UnixMilli(1675390885380),
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Name:  "Admin",
+		Email: "admin@harness.io",
+	}
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/main",
+			Before: "d74b1ebfe520ac01b209dd9f0d2a2a1fc5ba8a26",
+			After:  "e3b7b1e3b6c6e4d2f0a6b0a0c2a2d5d6f6f4b2c1",
+			Commit: scm.Commit{
+				Sha:       "e3b7b1e3b6c6e4d2f0a6b0a0c2a2d5d6f6f4b2c1",
+				Message:   "update readme",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{
+					Sha:       "e3b7b1e3b6c6e4d2f0a6b0a0c2a2d5d6f6f4b2c1",
+					Message:   "update readme",
+					Author:    signature,
+					Committer: signature,
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Body:   "adding build instructions to readme",
+				Sha:    "e3b7b1e3b6c6e4d2f0a6b0a0c2a2d5d6f6f4b2c1",
+				Ref:    "refs/heads/b",
+				Source: "b",
+				Target: "main",
+				Fork:   "fork",
+				Link:   "http://localhost:3000/kmpySmUISimoRrJL6NL73w/myOrg/myProject/aba/pulls/1",
+				Closed: true,
+				Merged: true,
+				Author: simulateSender,
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionLabel,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Sha:    "e3b7b1e3b6c6e4d2f0a6b0a0c2a2d5d6f6f4b2c1",
+				Ref:    "refs/heads/b",
+				Source: "b",
+				Target: "main",
+				Fork:   "fork",
+				Author: simulateSender,
+				Labels: []scm.Label{
+					{Name: "priority:high", Color: "red"},
+				},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/main", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
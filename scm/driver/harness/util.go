@@ -172,3 +172,25 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("git_ref", opts.Ref)
+	}
+	// harness expects the date filters as unix milliseconds.
+	if !opts.Since.IsZero() {
+		params.Set("since", strconv.FormatInt(opts.Since.UnixMilli(), 10))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", strconv.FormatInt(opts.Until.UnixMilli(), 10))
+	}
+	return params.Encode()
+}
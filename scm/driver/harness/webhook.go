@@ -30,7 +30,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Harness-Trigger") {
+	event := req.Header.Get("X-Harness-Trigger")
+	switch event {
 	// case "create":
 	// 	hook, err = s.parseCreateHook(data)
 	// case "delete":
@@ -45,14 +46,34 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseTagHook(data)
 	case "pullreq_created", "pullreq_reopened", "pullreq_branch_updated", "pullreq_closed", "pullreq_merged":
 		hook, err = s.parsePullRequestHook(data)
-	case "pullreq_comment_created":
+	case "pullreq_updated", "pullreq_label_assigned":
+		hook, err = s.parsePullRequestHook(data)
+	case "pullreq_comment_created", "pullreq_comment_updated", "pullreq_comment_deleted":
 		hook, err = s.parsePullRequestCommentHook(data)
+	case "pullreq_review_submitted":
+		hook, err = s.parseReviewHook(data)
+	case "check_status_updated":
+		hook, err = s.parseCheckHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		if s.client == nil || !s.client.RawWebhooks {
+			return nil, scm.ErrUnknownEvent
+		}
+		hook, err = s.parseRawHook(req, data)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if hook == nil {
+		return nil, nil
+	}
+
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverHarness,
+		Event:    event,
+		Received: time.Now(),
+	})
 
 	// get the gitea signature key to verify the payload
 	// signature. If no key is provided, no validation
@@ -103,6 +124,18 @@ func (s *webhookService) parsePullRequestCommentHook(data []byte) (scm.Webhook,
 	return convertPullRequestCommentHook(dst), err
 }
 
+func (s *webhookService) parseReviewHook(data []byte) (scm.Webhook, error) {
+	dst := new(reviewHook)
+	err := json.Unmarshal(data, dst)
+	return convertReviewHook(dst), err
+}
+
+func (s *webhookService) parseCheckHook(data []byte) (scm.Webhook, error) {
+	dst := new(checkHook)
+	err := json.Unmarshal(data, dst)
+	return convertCheckHook(dst), err
+}
+
 func (s *webhookService) parseBranchHook(data []byte) (scm.Webhook, error) {
 	// using pushHook object since it is same as branch events
 	dst := new(pushHook)
@@ -117,8 +150,29 @@ func (s *webhookService) parseTagHook(data []byte) (scm.Webhook, error) {
 	return convertTagHook(dst), err
 }
 
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	// harness webhooks do not include a delivery identifier.
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repo != nil {
+		dst.Repo = convertRepo(*src.Repo)
+	}
+	return dst, nil
+}
+
 // native data structures
 type (
+	// harness webhook payload for unrecognized events
+	rawHook struct {
+		Repo *repo `json:"repo"`
+	}
 	repo struct {
 		ID            int    `json:"id"`
 		Path          string `json:"path"`
@@ -140,6 +194,7 @@ type (
 		State         string      `json:"state"`
 		IsDraft       bool        `json:"is_draft"`
 		Title         string      `json:"title"`
+		Description   string      `json:"description"`
 		SourceRepoID  int         `json:"source_repo_id"`
 		SourceBranch  string      `json:"source_branch"`
 		TargetRepoID  int         `json:"target_repo_id"`
@@ -193,6 +248,25 @@ type (
 		ID   int    `json:"id"`
 		Text string `json:"text"`
 	}
+	label struct {
+		ID    int64  `json:"id"`
+		Key   string `json:"key"`
+		Value string `json:"value"`
+		Color string `json:"color"`
+	}
+	review struct {
+		Decision string    `json:"review_decision"`
+		Reviewer principal `json:"reviewer"`
+	}
+	check struct {
+		ID         int64  `json:"id"`
+		Identifier string `json:"identifier"`
+		Status     string `json:"status"`
+		Summary    string `json:"summary"`
+		Link       string `json:"link"`
+		Started    int64  `json:"started"`
+		Ended      int64  `json:"ended"`
+	}
 	// harness pull request webhook payload
 	pullRequestHook struct {
 		Trigger           string       `json:"trigger"`
@@ -205,6 +279,9 @@ type (
 		HeadCommit        hookCommit   `json:"head_commit"`
 		Commits           []hookCommit `json:"commits"`
 		TotalCommitsCount int64        `json:"total_commits_count"`
+		// the label is only included in the
+		// pullreq_label_assigned payload.
+		Label label `json:"label"`
 	}
 	// harness push webhook payload
 	pushHook struct {
@@ -231,16 +308,91 @@ type (
 		HeadCommit hookCommit `json:"head_commit"`
 		Comment    comment    `json:"comment"`
 	}
+	// harness pull request review webhook payload
+	reviewHook struct {
+		Trigger    string     `json:"trigger"`
+		Repo       repo       `json:"repo"`
+		Principal  principal  `json:"principal"`
+		PullReq    pullReq    `json:"pull_req"`
+		TargetRef  targetRef  `json:"target_ref"`
+		Ref        ref        `json:"ref"`
+		Sha        string     `json:"sha"`
+		HeadCommit hookCommit `json:"head_commit"`
+		Review     review     `json:"review"`
+	}
+	// harness check status webhook payload
+	checkHook struct {
+		Trigger   string    `json:"trigger"`
+		Repo      repo      `json:"repo"`
+		Principal principal `json:"principal"`
+		Sha       string    `json:"sha"`
+		Check     check     `json:"check"`
+	}
 )
 
 // native data structure conversion
 func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
-	return &scm.PullRequestHook{
+	dst := &scm.PullRequestHook{
 		Action:      convertPRAction(src.Trigger),
 		PullRequest: convertPullReq(src.PullReq, src.Ref, src.HeadCommit),
 		Repo:        convertRepo(src.Repo),
 		Sender:      convertUser(src.Principal),
 	}
+	if src.Label.Key != "" {
+		dst.PullRequest.Labels = append(dst.PullRequest.Labels, convertLabel(src.Label))
+	}
+	return dst
+}
+
+func convertReviewHook(src *reviewHook) *scm.ReviewHook {
+	reviewer := src.Review.Reviewer
+	if reviewer.UID == "" {
+		reviewer = src.Principal
+	}
+	return &scm.ReviewHook{
+		Action:      scm.ActionSubmit,
+		PullRequest: convertPullReq(src.PullReq, src.Ref, src.HeadCommit),
+		Repo:        convertRepo(src.Repo),
+		Review: scm.ReviewSubmission{
+			State:  convertReviewDecision(src.Review.Decision),
+			Sha:    src.Sha,
+			Author: convertUser(reviewer),
+		},
+		Sender: convertUser(src.Principal),
+	}
+}
+
+func convertCheckHook(src *checkHook) *scm.CheckRunHook {
+	dst := &scm.CheckRunHook{
+		Action: convertCheckAction(src.Check.Status),
+		Repo:   convertRepo(src.Repo),
+		CheckRun: scm.CheckRun{
+			ID:    src.Check.ID,
+			Name:  src.Check.Identifier,
+			Sha:   src.Sha,
+			State: convertCheckState(src.Check.Status),
+			Link:  src.Check.Link,
+		},
+		Sender: convertUser(src.Principal),
+	}
+	if src.Check.Started != 0 {
+		dst.CheckRun.Started = time.UnixMilli(src.Check.Started)
+	}
+	if src.Check.Ended != 0 {
+		dst.CheckRun.Completed = time.UnixMilli(src.Check.Ended)
+	}
+	return dst
+}
+
+func convertLabel(src label) scm.Label {
+	name := src.Key
+	if src.Value != "" {
+		name = src.Key + ":" + src.Value
+	}
+	return scm.Label{
+		Name:  name,
+		Color: src.Color,
+	}
 }
 
 func convertPushHook(src *pushHook) *scm.PushHook {
@@ -276,6 +428,7 @@ func convertHookCommit(c hookCommit) scm.Commit {
 
 func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequestCommentHook {
 	return &scm.PullRequestCommentHook{
+		Action:      convertCommentAction(src.Trigger),
 		PullRequest: convertPullReq(src.PullReq, src.Ref, src.HeadCommit),
 		Repo:        convertRepo(src.Repo),
 		Comment: scm.Comment{
@@ -322,11 +475,69 @@ func convertPRAction(src string) (action scm.Action) {
 		return scm.ActionClose
 	case "pullreq_merged":
 		return scm.ActionMerge
+	case "pullreq_updated":
+		return scm.ActionUpdate
+	case "pullreq_label_assigned":
+		return scm.ActionLabel
 	default:
 		return scm.ActionUnknown
 	}
 }
 
+func convertCommentAction(src string) (action scm.Action) {
+	switch strings.ToLower(src) {
+	case "pullreq_comment_created":
+		return scm.ActionCreate
+	case "pullreq_comment_updated":
+		return scm.ActionEdit
+	case "pullreq_comment_deleted":
+		return scm.ActionDelete
+	default:
+		return scm.ActionUnknown
+	}
+}
+
+func convertReviewDecision(src string) scm.ReviewState {
+	switch src {
+	case "approved":
+		return scm.ReviewStateApproved
+	case "changereq":
+		return scm.ReviewStateChangesRequested
+	case "reviewed":
+		return scm.ReviewStateCommented
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+func convertCheckAction(src string) (action scm.Action) {
+	switch src {
+	case "pending":
+		return scm.ActionRequest
+	case "running":
+		return scm.ActionStart
+	default:
+		return scm.ActionComplete
+	}
+}
+
+func convertCheckState(src string) scm.State {
+	switch src {
+	case "pending":
+		return scm.StatePending
+	case "running":
+		return scm.StateRunning
+	case "success", "failure_ignored":
+		return scm.StateSuccess
+	case "failure":
+		return scm.StateFailure
+	case "error":
+		return scm.StateError
+	default:
+		return scm.StateUnknown
+	}
+}
+
 func convertBranchAction(src string) (action scm.Action) {
 	switch strings.ToLower(src) {
 	case "branch_created":
@@ -353,6 +564,7 @@ func convertPullReq(pr pullReq, ref ref, commit hookCommit) scm.PullRequest {
 	return scm.PullRequest{
 		Number: pr.Number,
 		Title:  pr.Title,
+		Body:   pr.Description,
 		Closed: pr.State != "open",
 		Source: pr.SourceBranch,
 		Target: pr.TargetBranch,
@@ -13,6 +13,7 @@ import (
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -116,6 +117,52 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merged.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request updated
+		{
+			event:  "pullreq_updated",
+			before: "testdata/webhooks/pull_request_updated.json",
+			after:  "testdata/webhooks/pull_request_updated.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request label assigned
+		{
+			event:  "pullreq_label_assigned",
+			before: "testdata/webhooks/pull_request_label_assigned.json",
+			after:  "testdata/webhooks/pull_request_label_assigned.json.golden",
+			obj:    new(scm.PullRequestHook),
+		},
+		// pull request comment updated
+		{
+			event:  "pullreq_comment_updated",
+			before: "testdata/webhooks/pull_request_comment_updated.json",
+			after:  "testdata/webhooks/pull_request_comment_updated.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request comment deleted
+		{
+			event:  "pullreq_comment_deleted",
+			before: "testdata/webhooks/pull_request_comment_deleted.json",
+			after:  "testdata/webhooks/pull_request_comment_deleted.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request review submitted
+		{
+			event:  "pullreq_review_submitted",
+			before: "testdata/webhooks/pull_request_review_submitted.json",
+			after:  "testdata/webhooks/pull_request_review_submitted.json.golden",
+			obj:    new(scm.ReviewHook),
+		},
+
+		//
+		// check events
+		//
+		// check status updated
+		{
+			event:  "check_status_updated",
+			before: "testdata/webhooks/check_status_updated.json",
+			after:  "testdata/webhooks/check_status_updated.json.golden",
+			obj:    new(scm.CheckRunHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -147,7 +194,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 
@@ -172,6 +219,79 @@ func TestWebhooks(t *testing.T) {
 		// }
 	}
 }
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/branch_updated.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Harness-Trigger", "pullreq_target_branch_changed")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverHarness; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "pullreq_target_branch_changed"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "abhinav-git-sync"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/branch_updated.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Harness-Trigger", "pullreq_target_branch_changed")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/branch_updated.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Harness-Trigger", "branch_updated")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverHarness,
+		Event:  "branch_updated",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
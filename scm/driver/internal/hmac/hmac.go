@@ -13,6 +13,20 @@ import (
 	"strings"
 )
 
+// Sign returns the hex encoded hmac signature of the
+// message.
+func Sign(h func() hash.Hash, message, key []byte) string {
+	mac := hmac.New(h, key)
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignPrefix returns the hex encoded sha256 hmac signature
+// of the message, prefixed with the signing algorithm.
+func SignPrefix(message, key []byte) string {
+	return "sha256=" + Sign(sha256.New, message, key)
+}
+
 // Validate checks the hmac signature of the mssasge
 // using a hex encoded signature.
 func Validate(h func() hash.Hash, message, key []byte, signature string) bool {
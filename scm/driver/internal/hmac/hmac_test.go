@@ -166,3 +166,13 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestSign(t *testing.T) {
+	msg, key := []byte("bonjour monde"), []byte("topsecret")
+	if got, want := Sign(sha256.New, msg, key), "8ca57e2afbad9fea8860404575c2d61827995c62aacd4c514eae4c404896390b"; got != want {
+		t.Errorf("Want signature %s, got %s", want, got)
+	}
+	if sig := SignPrefix(msg, key); !ValidatePrefix(msg, key, sig) {
+		t.Errorf("Want prefixed signature %s valid", sig)
+	}
+}
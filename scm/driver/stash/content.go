@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -65,6 +66,14 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, opts
 	return convertContentInfoList(out), res, err
 }
 
+func (s *contentService) Blame(ctx context.Context, repo, path, ref string) ([]*scm.BlameRange, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s?at=%s&blame=true&noContent=true", namespace, name, path, url.QueryEscape(ref))
+	out := []*blame{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertBlameList(out), res, err
+}
+
 type contents struct {
 	pagination
 	Values []string `json:"values"`
@@ -87,3 +96,48 @@ func convertContentInfoList(from *contents) []*scm.ContentInfo {
 	}
 	return to
 }
+
+type blame struct {
+	Author             blameAuthor `json:"author"`
+	AuthorTimestamp    int64       `json:"authorTimestamp"`
+	Committer          blameAuthor `json:"committer"`
+	CommitterTimestamp int64       `json:"committerTimestamp"`
+	CommitHash         string      `json:"commitHash"`
+	LineNumber         int         `json:"lineNumber"`
+	SpannedLines       int         `json:"spannedLines"`
+}
+
+type blameAuthor struct {
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+	DisplayName  string `json:"displayName"`
+	Slug         string `json:"slug"`
+}
+
+// helper function converts the blame entries to ranges. The
+// blame api does not return the commit message.
+func convertBlameList(from []*blame) []*scm.BlameRange {
+	to := []*scm.BlameRange{}
+	for _, v := range from {
+		to = append(to, &scm.BlameRange{
+			StartLine: v.LineNumber,
+			EndLine:   v.LineNumber + v.SpannedLines - 1,
+			Commit: scm.Commit{
+				Sha:       v.CommitHash,
+				Author:    convertBlameAuthor(v.Author, v.AuthorTimestamp),
+				Committer: convertBlameAuthor(v.Committer, v.CommitterTimestamp),
+			},
+		})
+	}
+	return to
+}
+
+func convertBlameAuthor(from blameAuthor, timestamp int64) scm.Signature {
+	return scm.Signature{
+		Name:   from.DisplayName,
+		Email:  from.EmailAddress,
+		Date:   time.Unix(timestamp/1000, 0),
+		Login:  from.Slug,
+		Avatar: avatarLink(from.EmailAddress),
+	}
+}
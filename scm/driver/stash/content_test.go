@@ -176,3 +176,32 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentBlame(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		MatchParam("at", "master").
+		MatchParam("blame", "true").
+		MatchParam("noContent", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/blame.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Contents.Blame(context.Background(), "PRJ/my-repo", "README", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.BlameRange{}
+	raw, _ := os.ReadFile("testdata/blame.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
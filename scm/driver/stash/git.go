@@ -30,6 +30,41 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags", namespace, name)
+	in := &createTag{
+		Name:       params.Name,
+		StartPoint: params.Sha,
+		Message:    params.Message,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// bitbucket does not return the annotated tag message,
+	// so we echo back the input message.
+	to := convertTagDetails(out)
+	to.Message = params.Message
+	return to, res, nil
+}
+
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-utils/1.0/projects/%s/repos/%s/branches", namespace, repoName)
+	in := &deleteBranch{
+		Name: scm.ExpandRef(name, "refs/heads"),
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/git/1.0/projects/%s/repos/%s/tags/%s", namespace, repoName, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, branch string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?filterText=%s", namespace, name, branch)
@@ -54,6 +89,15 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) FindCommitDiff(ctx context.Context, repo, ref string) (*scm.Diff, *scm.Response, error) {
+	// the patch endpoint returns the commit in the email
+	// patch format, and the email headers are ignored
+	// when parsing the diff.
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/patch?until=%s", namespace, name, ref)
+	return s.client.diff(ctx, path)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?filterText=%s", namespace, name, tag)
@@ -70,6 +114,14 @@ func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Refere
 	return nil, res, scm.ErrNotFound
 }
 
+func (s *gitService) FindTagDetails(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags/%s", namespace, repoName, name)
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTagDetails(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?%s", namespace, name, encodeListOptions(opts))
@@ -102,6 +154,19 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	return convertCommitList(out), res, err
 }
 
+func (s *gitService) ListFileCommits(ctx context.Context, repo, path string, opts scm.FileCommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	// bitbucket server does not support filtering commits by date.
+	if !opts.Since.IsZero() || !opts.Until.IsZero() {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits?%s", namespace, name, encodeFileCommitListOptions(path, opts))
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCommitList(out), res, err
+}
+
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?%s", namespace, name, encodeListOptions(opts))
@@ -129,6 +194,73 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertDiffstats(out), res, err
 }
 
+// Compare compares the head commit with the base commit. The
+// merge base is not available in the rest api and is not set.
+func (s *gitService) Compare(ctx context.Context, repo, base, head string) (*scm.Comparison, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	ahead, res, err := s.listAllCommits(ctx, fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/commits?from=%s&to=%s", namespace, name, head, base))
+	if err != nil {
+		return nil, res, err
+	}
+	behind, res, err := s.listAllCommits(ctx, fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/commits?from=%s&to=%s", namespace, name, base, head))
+	if err != nil {
+		return nil, res, err
+	}
+	changes := new(diffstats)
+	for start := int64(0); ; {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/changes?from=%s&to=%s&start=%d&limit=100", namespace, name, head, base, start)
+		out := new(diffstats)
+		res, err = s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		changes.Values = append(changes.Values, out.Values...)
+		if out.LastPage.Bool || !out.NextPage.Valid {
+			break
+		}
+		start = out.NextPage.Int64
+	}
+	return &scm.Comparison{
+		Ahead:   len(ahead.Values),
+		Behind:  len(behind.Values),
+		Commits: convertCommitList(ahead),
+		Changes: convertDiffstats(changes),
+	}, res, nil
+}
+
+func (s *gitService) FindMergeBase(ctx context.Context, repo, base, head string) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/commits?from=%s&to=%s&limit=1", namespace, name, ancestor, descendant)
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return len(out.Values) == 0, res, nil
+}
+
+// helper function lists the commits, requesting the next page
+// until the last page is returned.
+func (s *gitService) listAllCommits(ctx context.Context, path string) (*commits, *scm.Response, error) {
+	all := new(commits)
+	for start := int64(0); ; {
+		out := new(commits)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s&start=%d&limit=100", path, start), nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		all.Values = append(all.Values, out.Values...)
+		if out.LastPage.Bool || !out.NextPage.Valid {
+			return all, res, nil
+		}
+		start = out.NextPage.Int64
+	}
+}
+
 type branch struct {
 	ID              string `json:"id"`
 	DisplayID       string `json:"displayId"`
@@ -177,6 +309,25 @@ type diffstat struct {
 	} `json:"properties"`
 }
 
+type tag struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	Type         string `json:"type"`
+	LatestCommit string `json:"latestCommit"`
+	Hash         string `json:"hash"`
+}
+
+type createTag struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
+type deleteBranch struct {
+	Name   string `json:"name"`
+	DryRun bool   `json:"dryRun"`
+}
+
 type createBranch struct {
 	Name       string `json:"name"`
 	StartPoint string `json:"startPoint"`
@@ -315,3 +466,19 @@ func convertTag(from *branch) *scm.Reference {
 		Sha:  from.LatestCommit,
 	}
 }
+
+// helper function to convert from the bitbucket tag structure
+// to the common tag structure. The hash is the sha of the tag
+// object, and is empty for lightweight tags. Bitbucket does not
+// return the annotated tag message or tagger.
+func convertTagDetails(from *tag) *scm.Tag {
+	to := &scm.Tag{
+		Name: from.DisplayID,
+		Path: from.ID,
+		Sha:  from.LatestCommit,
+	}
+	if from.Hash != from.LatestCommit {
+		to.Object = from.Hash
+	}
+	return to
+}
@@ -41,6 +41,33 @@ func TestGitFindCommit(t *testing.T) {
 	}
 }
 
+func TestGitFindCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/patch").
+		MatchParam("until", "131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.patch")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.FindCommitDiff(context.Background(), "PRJ/my-repo", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -93,6 +120,106 @@ func TestGitFindTag(t *testing.T) {
 	}
 }
 
+func TestGitFindTagDetails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.1.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_annotated.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.FindTagDetails(context.Background(), "PRJ/my-repo", "v1.1.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{
+		Name:   "v1.1.0",
+		Path:   "refs/tags/v1.1.0",
+		Object: "8d51122def5632836d1cb1026e879069e10a1e13",
+		Sha:    "11ce869211917dd65610e70fcee454943b35ac6e",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]interface{}{
+			"name":       "v1.1.0",
+			"startPoint": "11ce869211917dd65610e70fcee454943b35ac6e",
+			"message":    "release v1.1.0",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_annotated.json")
+
+	params := &scm.TagInput{
+		Name:    "v1.1.0",
+		Sha:     "11ce869211917dd65610e70fcee454943b35ac6e",
+		Message: "release v1.1.0",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.CreateTag(context.Background(), "PRJ/my-repo", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{
+		Name:    "v1.1.0",
+		Path:    "refs/tags/v1.1.0",
+		Message: "release v1.1.0",
+		Object:  "8d51122def5632836d1cb1026e879069e10a1e13",
+		Sha:     "11ce869211917dd65610e70fcee454943b35ac6e",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.1.0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.DeleteTag(context.Background(), "PRJ/my-repo", "v1.1.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]interface{}{
+			"name":   "refs/heads/feature",
+			"dryRun": false,
+		}).
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.DeleteBranch(context.Background(), "PRJ/my-repo", "feature")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -119,6 +246,37 @@ func TestGitListCommits(t *testing.T) {
 	}
 }
 
+func TestGitListFileCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits").
+		MatchParam("path", "README").
+		MatchParam("until", "master").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	opts := scm.FileCommitListOptions{Ref: "master", Size: 25}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.ListFileCommits(context.Background(), "PRJ/my-repo", "README", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := os.ReadFile("testdata/commits.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +414,79 @@ func TestGitCompareChanges(t *testing.T) {
 		t.Log(diff)
 	}
 }
+func TestGitCompare(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/commits").
+		MatchParam("from", "131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		MatchParam("to", "4f4b0ef1714a5b6cafdaf2f53c7f5f5b38fb9348").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/commits").
+		MatchParam("from", "4f4b0ef1714a5b6cafdaf2f53c7f5f5b38fb9348").
+		MatchParam("to", "131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits_empty.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/changes").
+		MatchParam("from", "131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		MatchParam("to", "4f4b0ef1714a5b6cafdaf2f53c7f5f5b38fb9348").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.Compare(context.Background(), "PRJ/my-repo", "4f4b0ef1714a5b6cafdaf2f53c7f5f5b38fb9348", "131cb13f4aed12e725177bc4b7c28db67839bf9f")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.Ahead, 1; got != want {
+		t.Errorf("Want ahead by %d, got %d", want, got)
+	}
+	if got, want := got.Behind, 0; got != want {
+		t.Errorf("Want behind by %d, got %d", want, got)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := os.ReadFile("testdata/compare.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Changes, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitIsAncestor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/compare/commits").
+		MatchParam("from", "master").
+		MatchParam("to", "feature").
+		MatchParam("limit", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits_empty.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.IsAncestor(context.Background(), "PRJ/my-repo", "master", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !got {
+		t.Errorf("Want master to be an ancestor of feature")
+	}
+}
 
 func TestCreateBranch(t *testing.T) {
 	defer gock.Off()
@@ -24,6 +24,12 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) FindDiff(ctx context.Context, repo string, number int) (*scm.Diff, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d.diff", namespace, name, number)
+	return s.client.diff(ctx, path)
+}
+
 func (s *pullService) FindComment(ctx context.Context, repo string, number int, id int) (*scm.Comment, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
@@ -84,6 +90,31 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, input *scm.MergeInput) (*scm.Response, error) {
+	if input.DeleteSourceBranch {
+		return nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	// bitbucket server requires the current pull request
+	// version, which doubles as the optimistic lock for the
+	// expected head commit.
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	if input.Sha != "" && input.Sha != out.FromRef.LatestCommit {
+		return res, fmt.Errorf("bitbucket: pull request head %s does not match expected sha %s", out.FromRef.LatestCommit, input.Sha)
+	}
+	in := &mergeInput{
+		Message:    scm.JoinCommitMessage(input.CommitTitle, input.CommitMessage),
+		StrategyID: convertFromMergeMethod(input.Method),
+	}
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/merge?version=%d", namespace, name, number, out.Version)
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
@@ -197,6 +228,11 @@ type prs struct {
 	Values []*pr `json:"values"`
 }
 
+type mergeInput struct {
+	Message    string `json:"message,omitempty"`
+	StrategyID string `json:"strategyId,omitempty"`
+}
+
 type prInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
@@ -316,3 +352,18 @@ func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
 		},
 	}
 }
+
+func convertFromMergeMethod(from scm.MergeMethod) string {
+	switch from {
+	case scm.MergeMethodMerge:
+		return "no-ff"
+	case scm.MergeMethodSquash:
+		return "squash"
+	case scm.MergeMethodRebase:
+		return "rebase-no-ff"
+	case scm.MergeMethodFastForward:
+		return "ff-only"
+	default:
+		return ""
+	}
+}
@@ -66,6 +66,32 @@ func TestPullFindComment(t *testing.T) {
 	}
 }
 
+func TestPullFindDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.FindDiff(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.FileDiff{}
+	raw, _ := os.ReadFile("testdata/commit.diff.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got.Files, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -134,6 +160,57 @@ func TestPullMerge(t *testing.T) {
 	}
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		MatchParam("version", "0").
+		JSON(map[string]string{
+			"message":    "Merge feature",
+			"strategyId": "squash",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.MergeInput{
+		Method:      scm.MergeMethodSquash,
+		CommitTitle: "Merge feature",
+		Sha:         "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullMergeWithOptions_ShaMismatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.MergeInput{Sha: "0000000000000000000000000000000000000000"}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "PRJ/my-repo", 1, input)
+	if err == nil {
+		t.Errorf("Expect error when the head sha does not match")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
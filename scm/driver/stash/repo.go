@@ -6,10 +6,12 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -39,6 +41,25 @@ type repository struct {
 	} `json:"links"`
 }
 
+type repositoryInput struct {
+	Name          string `json:"name,omitempty"`
+	ScmID         string `json:"scmId,omitempty"`
+	Description   string `json:"description,omitempty"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+	Public        *bool  `json:"public,omitempty"`
+	Archived      *bool  `json:"archived,omitempty"`
+}
+
+type forkInput struct {
+	Project *struct {
+		Key string `json:"key"`
+	} `json:"project,omitempty"`
+}
+
+type defaultBranchInput struct {
+	ID string `json:"id"`
+}
+
 type repositories struct {
 	pagination
 	Values []*repository `json:"values"`
@@ -75,6 +96,22 @@ type hook struct {
 	} `json:"configuration"`
 }
 
+type hookInvocation struct {
+	ID       int    `json:"id"`
+	Event    string `json:"event"`
+	Duration int64  `json:"duration"`
+	Start    int64  `json:"start"`
+	Finish   int64  `json:"finish"`
+	Request  struct {
+		URL    string `json:"url"`
+		Method string `json:"method"`
+	} `json:"request"`
+	Result struct {
+		Description string `json:"description"`
+		Outcome     string `json:"outcome"`
+	} `json:"result"`
+}
+
 type hookInput struct {
 	Name   string   `json:"name"`
 	Events []string `json:"events"`
@@ -223,15 +260,7 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks", namespace, name)
-	in := new(hookInput)
-	in.URL = input.Target
-	in.Active = true
-	in.Name = input.Name
-	in.Config.Secret = input.Secret
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	if err != nil && isUnknownHookEvent(err) {
@@ -264,15 +293,7 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s", namespace, name, id)
-	in := new(hookInput)
-	in.URL = input.Target
-	in.Active = true
-	in.Name = input.Name
-	in.Config.Secret = input.Secret
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PUT", path, in, out)
 	if err != nil && isUnknownHookEvent(err) {
@@ -289,6 +310,142 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries returns the most recent webhook invocation.
+// Bitbucket Server only retains the latest invocation of each
+// webhook, so the list contains at most one delivery.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo string, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s/latest", namespace, name, id)
+	out := new(hookInvocation)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 204 {
+		return []*scm.HookDelivery{}, res, nil
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	return []*scm.HookDelivery{convertHookInvocation(id, out)}, res, nil
+}
+
+// FindHookDelivery returns a webhook delivery. Only the most
+// recent invocation can be found, and the request and response
+// headers and body are not retained by Bitbucket Server.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo string, id string, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s/latest", namespace, name, id)
+	out := new(hookInvocation)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 204 {
+		return nil, res, scm.ErrNotFound
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	if strconv.Itoa(out.ID) != delivery {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertHookInvocation(id, out), res, nil
+}
+
+// RedeliverHook redelivers a webhook delivery.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo string, id string, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// Create creates a new repository.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// bitbucket server cannot initialize the repository
+	// contents or create internal repositories.
+	if input.Readme || input.Gitignore != "" || input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// repositories always belong to a project; personal
+	// repositories use the ~username project key.
+	if input.Namespace == "" {
+		return nil, nil, errors.New("bitbucket: a project key is required to create a repository")
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos", input.Namespace)
+	in := convertFromRepositoryInput(input)
+	in.ScmID = "git"
+	in.DefaultBranch = input.Branch
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	repo := convertRepository(out)
+	if input.Branch != "" {
+		repo.Branch = input.Branch
+	}
+	return repo, res, err
+}
+
+// Fork forks the repository into the project, or the
+// user's personal project when empty.
+func (s *repositoryService) Fork(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	project, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", project, name)
+	in := new(forkInput)
+	if namespace != "" {
+		in.Project = &struct {
+			Key string `json:"key"`
+		}{Key: namespace}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates the repository settings.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Visibility == scm.VisibilityInternal {
+		return nil, nil, scm.ErrNotSupported
+	}
+	project, name := scm.Split(repo)
+	if input.Branch != "" {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches/default", project, name)
+		in := &defaultBranchInput{ID: scm.ExpandRef(input.Branch, "refs/heads")}
+		res, err := s.client.do(ctx, "PUT", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", project, name)
+	in := convertFromRepositoryInput(input)
+	if input.Archived {
+		archived := true
+		in.Archived = &archived
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	updated := convertRepository(out)
+	if input.Branch != "" {
+		updated.Branch = input.Branch
+	}
+	return updated, res, err
+}
+
+// Archive archives the repository.
+func (s *repositoryService) Archive(ctx context.Context, repo string) (*scm.Response, error) {
+	return s.archive(ctx, repo, true)
+}
+
+// Unarchive unarchives the repository.
+func (s *repositoryService) Unarchive(ctx context.Context, repo string) (*scm.Response, error) {
+	return s.archive(ctx, repo, false)
+}
+
+// Delete deletes the repository.
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) archive(ctx context.Context, repo string, archived bool) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	in := &repositoryInput{Archived: &archived}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -314,6 +471,20 @@ func convertRepository(from *repository) *scm.Repository {
 	}
 }
 
+// helper function to convert from the common repository input
+// to the bitbucket server repository input structure.
+func convertFromRepositoryInput(from *scm.RepositoryInput) *repositoryInput {
+	to := &repositoryInput{
+		Name:        from.Name,
+		Description: from.Description,
+	}
+	if from.Visibility != scm.VisibilityUndefined {
+		public := from.Visibility == scm.VisibilityPublic
+		to.Public = &public
+	}
+	return to
+}
+
 func extractLink(links []link, name string) (href string) {
 	for _, link := range links {
 		if link.Name == name {
@@ -357,6 +528,35 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertHookInvocation(hookID string, from *hookInvocation) *scm.HookDelivery {
+	// the result description contains the response status
+	// code, or an error message if the request failed.
+	status, _ := strconv.Atoi(from.Result.Description)
+	return &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		HookID:   hookID,
+		Event:    from.Event,
+		Target:   from.Request.URL,
+		Status:   status,
+		Success:  from.Result.Outcome == "SUCCESS",
+		Duration: time.Duration(from.Duration) * time.Millisecond,
+		Created:  time.Unix(0, from.Start*int64(time.Millisecond)).UTC(),
+	}
+}
+
+func convertFromHookInput(from *scm.HookInput) *hookInput {
+	to := new(hookInput)
+	to.URL = from.Target
+	to.Active = true
+	to.Name = from.Name
+	to.Config.Secret = from.Secret
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push || from.Branch || from.Tag {
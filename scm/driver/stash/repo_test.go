@@ -532,6 +532,255 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_latest.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListHookDeliveries(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := os.ReadFile("testdata/webhook_latest.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookDeliveryListEmpty(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListHookDeliveries(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want empty delivery list, got %d", len(got))
+	}
+}
+
+func TestRepositoryHookDeliveryFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_latest.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.FindHookDelivery(context.Background(), "PRJ/my-repo", "1", "42")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := os.ReadFile("testdata/webhook_latest.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookDeliveryFindNotLatest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_latest.json")
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Repositories.FindHookDelivery(context.Background(), "PRJ/my-repo", "1", "41")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos").
+		JSON(map[string]interface{}{
+			"name":          "my-repo",
+			"scmId":         "git",
+			"defaultBranch": "main",
+			"public":        false,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:  "PRJ",
+		Name:       "my-repo",
+		Branch:     "main",
+		Visibility: scm.VisibilityPrivate,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := os.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+	want.Branch = "main"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{
+			"project": map[string]interface{}{"key": "~JCITIZEN"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Repositories.Fork(context.Background(), "PRJ/my-repo", "~JCITIZEN")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/branches/default").
+		JSON(map[string]interface{}{"id": "refs/heads/develop"}).
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{
+			"description": "my repository",
+			"public":      true,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Description: "my repository",
+		Branch:      "develop",
+		Visibility:  scm.VisibilityPublic,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Update(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.Branch, "develop"; got != want {
+		t.Errorf("Want default branch %s, got %s", want, got)
+	}
+}
+
+func TestRepositoryUpdateArchived(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{Archived: true}
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Repositories.Update(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{"archived": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.Archive(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUnarchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		JSON(map[string]interface{}{"archived": false}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.Unarchive(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		Reply(202).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.Delete(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -6,6 +6,9 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +18,494 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReviewComment(out, nil, out.Anchor), res, err
 }
 
+// List returns the file comments and the replies to the file
+// comments. Comments are listed using the activities endpoint,
+// so the page size includes activities that are not comments.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertActivityList(out.Values), res, err
 }
 
+// Create creates a file comment anchored to the diff, or replies
+// to the comment identified by InReplyTo. The thread is
+// identified by the first comment id.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := &reviewCommentInput{Text: input.Body}
+	parent := input.InReplyTo
+	if parent == 0 {
+		parent, _ = strconv.Atoi(input.Thread)
+	}
+	if parent != 0 {
+		in.Parent = &commentID{ID: parent}
+	} else {
+		// the line type is required to anchor the comment,
+		// which is found in the pull request diff.
+		diffpath := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/diff/%s", namespace, name, number, input.Path)
+		diff := new(fileDiff)
+		res, err := s.client.do(ctx, "GET", diffpath, nil, diff)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Anchor = convertFromReviewInput(input, diff)
+	}
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	to := convertReviewComment(out, nil, in.Anchor)
+	if parent != 0 {
+		to.InReplyTo = parent
+		to.Thread = strconv.Itoa(parent)
+	}
+	return to, res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	// the comment version is required to delete the
+	// comment, which is fetched from the comment.
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("%s?version=%d", path, out.Version)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Resolve resolves the comment thread. The thread is identified
+// by the first comment id.
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolve(ctx, repo, number, thread, true)
+}
+
+// Unresolve reopens the comment thread. The thread is identified
+// by the first comment id.
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolve(ctx, repo, number, thread, false)
+}
+
+func (s *reviewService) resolve(ctx context.Context, repo string, number int, thread string, resolved bool) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%s", namespace, name, number, thread)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	in := &threadInput{
+		Version:        out.Version,
+		ThreadResolved: resolved,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// Submit approves the pull request, or marks the pull request as
+// needs work, on behalf of the authenticated user. The review body
+// is added as a pull request comment. Bitbucket Server does not
+// support submitting a batch of review comments.
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.ReviewSubmitInput) (*scm.ReviewSubmission, *scm.Response, error) {
+	if len(input.Comments) != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	var status string
+	switch input.State {
+	case scm.ReviewStateApproved:
+		status = "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		status = "NEEDS_WORK"
+	case scm.ReviewStateCommented:
+		if input.Body == "" {
+			return nil, nil, scm.ErrNotSupported
+		}
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	to := &scm.ReviewSubmission{
+		Body:  input.Body,
+		State: input.State,
+		Sha:   input.Sha,
+	}
+	var res *scm.Response
+	var err error
+	if input.Body != "" {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+		in := &pullRequestCommentInput{Text: input.Body}
+		out := new(pullRequestComment)
+		res, err = s.client.do(ctx, "POST", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		to.ID = out.ID
+		to.Submitted = time.Unix(out.CreatedDate/1000, 0)
+	}
+	if status != "" {
+		// the participant status is updated using the user slug,
+		// which requires looking up the authenticated user.
+		self, res, err := (&userService{s.client}).Find(ctx)
+		if err != nil {
+			return nil, res, err
+		}
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, self.Login)
+		in := &participantInput{Status: status}
+		in.User.Name = self.Login
+		out := new(participant)
+		res, err = s.client.do(ctx, "PUT", path, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		to.Author = *convertUser(&out.User)
+		return to, res, nil
+	}
+	return to, res, err
+}
+
+// ListSubmissions returns the pull request participants that
+// approved or marked the pull request as needs work.
+func (s *reviewService) ListSubmissions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewSubmission, *scm.Response, error) {
+	out, res, err := s.findParticipants(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertParticipantSubmissionList(out), res, nil
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviewers(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reviewer, *scm.Response, error) {
+	out, res, err := s.findParticipants(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertParticipantReviewerList(out.Reviewers), res, nil
+}
+
+func (s *reviewService) RequestReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return s.updateReviewers(ctx, repo, number, func(reviewers []string) []string {
+		for _, login := range logins {
+			if !contains(reviewers, login) {
+				reviewers = append(reviewers, login)
+			}
+		}
+		return reviewers
+	})
+}
+
+func (s *reviewService) RemoveReviewers(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
+	return s.updateReviewers(ctx, repo, number, func(reviewers []string) []string {
+		var keep []string
+		for _, reviewer := range reviewers {
+			if !contains(logins, reviewer) {
+				keep = append(keep, reviewer)
+			}
+		}
+		return keep
+	})
+}
+
+func (s *reviewService) findParticipants(ctx context.Context, repo string, number int) (*prParticipants, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+// helper function updates the pull request reviewers. Bitbucket
+// Server replaces the reviewer list on update, and requires the
+// current pull request version.
+func (s *reviewService) updateReviewers(ctx context.Context, repo string, number int, fn func([]string) []string) (*scm.Response, error) {
+	out, res, err := s.findParticipants(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	var reviewers []string
+	for _, v := range out.Reviewers {
+		reviewers = append(reviewers, v.User.Name)
+	}
+	in := &reviewersInput{
+		Version:   out.Version,
+		Title:     out.Title,
+		Reviewers: []*participantInput{},
+	}
+	for _, v := range fn(reviewers) {
+		reviewer := new(participantInput)
+		reviewer.User.Name = v
+		in.Reviewers = append(in.Reviewers, reviewer)
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type activities struct {
+	pagination
+	Values []*activity `json:"values"`
+}
+
+type activity struct {
+	Action        string         `json:"action"`
+	CommentAction string         `json:"commentAction"`
+	Comment       *reviewComment `json:"comment"`
+	CommentAnchor *anchor        `json:"commentAnchor"`
+}
+
+type reviewComment struct {
+	ID             int              `json:"id"`
+	Version        int              `json:"version"`
+	Text           string           `json:"text"`
+	Author         user             `json:"author"`
+	CreatedDate    int64            `json:"createdDate"`
+	UpdatedDate    int64            `json:"updatedDate"`
+	Anchor         *anchor          `json:"anchor"`
+	ThreadResolved bool             `json:"threadResolved"`
+	Comments       []*reviewComment `json:"comments"`
+}
+
+type anchor struct {
+	Path                   string `json:"path"`
+	Line                   int    `json:"line"`
+	LineType               string `json:"lineType"`
+	FileType               string `json:"fileType"`
+	DiffType               string `json:"diffType,omitempty"`
+	FromHash               string `json:"fromHash,omitempty"`
+	ToHash                 string `json:"toHash,omitempty"`
+	MultilineStartLine     int    `json:"multilineStartLine,omitempty"`
+	MultilineStartLineType string `json:"multilineStartLineType,omitempty"`
+}
+
+type fileDiff struct {
+	Diffs []struct {
+		Hunks []struct {
+			Segments []struct {
+				Type  string `json:"type"`
+				Lines []struct {
+					Source      int `json:"source"`
+					Destination int `json:"destination"`
+				} `json:"lines"`
+			} `json:"segments"`
+		} `json:"hunks"`
+	} `json:"diffs"`
+}
+
+// helper function returns the type of the line in the diff,
+// where the line is the source line number for the base side
+// of the diff, and the destination line number for the head
+// side of the diff.
+func (d *fileDiff) lineType(fileType string, line int) string {
+	for _, diff := range d.Diffs {
+		for _, hunk := range diff.Hunks {
+			for _, segment := range hunk.Segments {
+				// removed lines do not exist on the head side,
+				// and added lines do not exist on the base side.
+				if (fileType == "TO" && segment.Type == "REMOVED") ||
+					(fileType == "FROM" && segment.Type == "ADDED") {
+					continue
+				}
+				for _, v := range segment.Lines {
+					if (fileType == "TO" && v.Destination == line) ||
+						(fileType == "FROM" && v.Source == line) {
+						return segment.Type
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+type reviewCommentInput struct {
+	Text   string     `json:"text"`
+	Anchor *anchor    `json:"anchor,omitempty"`
+	Parent *commentID `json:"parent,omitempty"`
+}
+
+type commentID struct {
+	ID int `json:"id"`
+}
+
+type threadInput struct {
+	Version        int  `json:"version"`
+	ThreadResolved bool `json:"threadResolved"`
+}
+
+type prParticipants struct {
+	Version      int            `json:"version"`
+	Title        string         `json:"title"`
+	Reviewers    []*participant `json:"reviewers"`
+	Participants []*participant `json:"participants"`
+}
+
+type participant struct {
+	User     user   `json:"user"`
+	Role     string `json:"role"`
+	Approved bool   `json:"approved"`
+	Status   string `json:"status"`
+}
+
+type participantInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Status string `json:"status,omitempty"`
+}
+
+type reviewersInput struct {
+	Version   int                 `json:"version"`
+	Title     string              `json:"title"`
+	Reviewers []*participantInput `json:"reviewers"`
+}
+
+// helper function converts the file comment activities and
+// the nested replies to the file comments.
+func convertActivityList(from []*activity) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		if v.Action != "COMMENTED" || v.CommentAction != "ADDED" {
+			continue
+		}
+		if v.Comment == nil || v.CommentAnchor == nil {
+			continue
+		}
+		to = appendReviewComments(to, v.Comment, v.Comment, v.CommentAnchor, 0)
+	}
+	return to
+}
+
+// helper function appends the comment and the nested replies.
+func appendReviewComments(to []*scm.Review, from, root *reviewComment, anchor *anchor, parent int) []*scm.Review {
+	review := convertReviewComment(from, root, anchor)
+	review.InReplyTo = parent
+	to = append(to, review)
+	for _, v := range from.Comments {
+		to = appendReviewComments(to, v, root, anchor, from.ID)
+	}
+	return to
+}
+
+func convertReviewComment(from, root *reviewComment, anchor *anchor) *scm.Review {
+	if root == nil {
+		root = from
+	}
+	to := &scm.Review{
+		ID:       from.ID,
+		Body:     from.Text,
+		Thread:   strconv.Itoa(root.ID),
+		Resolved: root.ThreadResolved,
+		Author:   *convertUser(&from.Author),
+		Created:  time.Unix(from.CreatedDate/1000, 0),
+		Updated:  time.Unix(from.UpdatedDate/1000, 0),
+	}
+	if anchor != nil {
+		to.Path = anchor.Path
+		to.Line = anchor.Line
+		to.EndLine = anchor.Line
+		to.Sha = anchor.ToHash
+		to.StartLine = anchor.MultilineStartLine
+		if anchor.FileType == "FROM" {
+			to.Side = scm.DiffSideBase
+			to.Sha = anchor.FromHash
+		}
+	}
+	return to
+}
+
+// helper function converts the review input to the comment
+// anchor. The line type is found in the diff, and defaults to
+// an added line on the head side and a removed line on the
+// base side when the line is not included in the diff.
+func convertFromReviewInput(from *scm.ReviewInput, diff *fileDiff) *anchor {
+	// the line number defaults to the line for compatibility
+	// with callers that do not set the end line.
+	line := from.EndLine
+	if line == 0 {
+		line = from.Line
+	}
+	to := &anchor{
+		Path:     from.Path,
+		Line:     line,
+		LineType: "ADDED",
+		FileType: "TO",
+		DiffType: "EFFECTIVE",
+		ToHash:   from.Sha,
+	}
+	if from.Side == scm.DiffSideBase {
+		to.LineType = "REMOVED"
+		to.FileType = "FROM"
+		to.ToHash = ""
+		to.FromHash = from.Sha
+	}
+	if v := diff.lineType(to.FileType, line); v != "" {
+		to.LineType = v
+	}
+	if from.StartLine != 0 && from.StartLine != line {
+		to.MultilineStartLine = from.StartLine
+		to.MultilineStartLineType = to.LineType
+		if v := diff.lineType(to.FileType, from.StartLine); v != "" {
+			to.MultilineStartLineType = v
+		}
+	}
+	return to
+}
+
+func convertParticipantSubmissionList(from *prParticipants) []*scm.ReviewSubmission {
+	to := []*scm.ReviewSubmission{}
+	for _, v := range append(from.Reviewers, from.Participants...) {
+		state := convertParticipantState(v)
+		if state == scm.ReviewStatePending {
+			continue
+		}
+		to = append(to, &scm.ReviewSubmission{
+			State:  state,
+			Author: *convertUser(&v.User),
+		})
+	}
+	return to
+}
+
+func convertParticipantReviewerList(from []*participant) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	for _, v := range from {
+		to = append(to, &scm.Reviewer{
+			User:  *convertUser(&v.User),
+			State: convertParticipantState(v),
+		})
+	}
+	return to
+}
+
+func convertParticipantState(from *participant) scm.ReviewState {
+	switch from.Status {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "NEEDS_WORK":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStatePending
+	}
+}
+
+// helper function returns true if the slice contains the string.
+func contains(from []string, s string) bool {
+	for _, v := range from {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -6,35 +6,320 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
-func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_activities.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.List(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
 	}
-}
 
-func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	want := []*scm.Review{}
+	raw, _ := os.ReadFile("testdata/pr_activities.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/diff/main.go").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_diff.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "rename this variable",
+			"anchor": map[string]interface{}{
+				"path":                   "main.go",
+				"line":                   14,
+				"lineType":               "ADDED",
+				"fileType":               "TO",
+				"diffType":               "EFFECTIVE",
+				"toHash":                 "9d1f2e3c4b5a69788796a5b4c3d2e1f0a9b8c7d6",
+				"multilineStartLine":     12,
+				"multilineStartLineType": "ADDED",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 11, "version": 0, "text": "rename this variable", "author": {"name": "jcitizen", "slug": "jcitizen"}}`)
+
+	input := &scm.ReviewInput{
+		Body:      "rename this variable",
+		Sha:       "9d1f2e3c4b5a69788796a5b4c3d2e1f0a9b8c7d6",
+		Path:      "main.go",
+		StartLine: 12,
+		Line:      14,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.ID != 11 || got.Thread != "11" || got.StartLine != 12 || got.Line != 14 {
+		t.Errorf("Unexpected review comment %d in thread %s at %d-%d", got.ID, got.Thread, got.StartLine, got.Line)
+	}
+}
+
+func TestReviewCreateContext(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/diff/main.go").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_diff.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "this block can be simplified",
+			"anchor": map[string]interface{}{
+				"path":                   "main.go",
+				"line":                   15,
+				"lineType":               "CONTEXT",
+				"fileType":               "TO",
+				"diffType":               "EFFECTIVE",
+				"toHash":                 "9d1f2e3c4b5a69788796a5b4c3d2e1f0a9b8c7d6",
+				"multilineStartLine":     11,
+				"multilineStartLineType": "CONTEXT",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 12, "version": 0, "text": "this block can be simplified", "author": {"name": "jcitizen", "slug": "jcitizen"}}`)
+
+	input := &scm.ReviewInput{
+		Body:      "this block can be simplified",
+		Sha:       "9d1f2e3c4b5a69788796a5b4c3d2e1f0a9b8c7d6",
+		Path:      "main.go",
+		StartLine: 11,
+		Line:      15,
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewCreateBase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/diff/main.go").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_diff.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "why was this removed?",
+			"anchor": map[string]interface{}{
+				"path":                   "main.go",
+				"line":                   12,
+				"lineType":               "REMOVED",
+				"fileType":               "FROM",
+				"diffType":               "EFFECTIVE",
+				"fromHash":               "4f3ba3f7c8b1e1b5b5c0e2a9a8f5d4c3b2a1f0e9",
+				"multilineStartLine":     10,
+				"multilineStartLineType": "CONTEXT",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id": 13, "version": 0, "text": "why was this removed?", "author": {"name": "jcitizen", "slug": "jcitizen"}}`)
+
+	input := &scm.ReviewInput{
+		Body:      "why was this removed?",
+		Sha:       "4f3ba3f7c8b1e1b5b5c0e2a9a8f5d4c3b2a1f0e9",
+		Path:      "main.go",
+		Side:      scm.DiffSideBase,
+		StartLine: 10,
+		Line:      12,
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/12").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 12, "version": 3, "text": "done"}`)
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/12").
+		MatchParam("version", "3").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	if _, err := client.Reviews.Delete(context.Background(), "PRJ/my-repo", 1, 12); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review comment to be deleted")
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/11").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 11, "version": 2, "text": "rename this variable"}`)
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/11").
+		JSON(map[string]interface{}{"version": 2, "threadResolved": true}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id": 11, "version": 3, "text": "rename this variable", "threadResolved": true}`)
+
+	client, _ := New("http://example.com:7990")
+	if _, err := client.Reviews.Resolve(context.Background(), "PRJ/my-repo", 1, "11"); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review thread to be resolved")
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("plugins/servlet/applinks/whoami").
+		Reply(200).
+		Type("text/plain").
+		BodyString("jcitizen")
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/users/jcitizen").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jcitizen").
+		JSON(map[string]interface{}{
+			"user":   map[string]interface{}{"name": "jcitizen"},
+			"status": "APPROVED",
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"user": {"name": "jcitizen", "slug": "jcitizen", "displayName": "Jane Citizen"}, "role": "REVIEWER", "approved": true, "status": "APPROVED"}`)
+
+	client, _ := New("http://example.com:7990")
+	input := &scm.ReviewSubmitInput{State: scm.ReviewStateApproved}
+	got, _, err := client.Reviews.Submit(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.State != scm.ReviewStateApproved {
+		t.Errorf("Want approved review state, got %s", got.State)
+	}
+	if got.Author.Login != "jcitizen" {
+		t.Errorf("Want review author jcitizen, got %s", got.Author.Login)
+	}
+}
+
+func TestReviewListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ListReviewers(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := os.ReadFile("testdata/pr_reviewers.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		JSON(map[string]interface{}{
+			"version": 3,
+			"title":   "added LICENSE",
+			"reviewers": []map[string]interface{}{
+				{"user": map[string]interface{}{"name": "jcitizen"}},
+				{"user": map[string]interface{}{"name": "jdoe"}},
+				{"user": map[string]interface{}{"name": "rsmith"}},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.RequestReviewers(context.Background(), "PRJ/my-repo", 1, []string{"jdoe", "rsmith"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers to be updated")
 	}
 }
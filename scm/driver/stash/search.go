@@ -0,0 +1,136 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	limit := defaultLimit
+	if opts.Size != 0 {
+		limit = opts.Size
+	}
+	start := 0
+	if opts.Page > 1 {
+		start = (opts.Page - 1) * limit
+	}
+	in := &searchInput{
+		Query: encodeSearchQuery(opts),
+	}
+	in.Entities.Code = &searchEntity{Start: start, Limit: limit}
+	in.Limits.Primary = limit
+	out := new(searchResults)
+	res, err := s.client.do(ctx, "POST", "rest/search/latest/search", in, out)
+	if res != nil {
+		res.Page.First = 1
+		if !out.Code.IsLastPage {
+			res.Page.Next = out.Code.NextStart/limit + 1
+		}
+	}
+	return convertCodeResultList(out.Code.Values), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.SearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchOptions) ([]*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// helper function appends the project and repository
+// modifiers to the search query.
+func encodeSearchQuery(opts scm.SearchOptions) string {
+	q := opts.Query
+	if opts.Repo != "" {
+		namespace, name := scm.Split(opts.Repo)
+		q += " project:" + namespace + " repo:" + name
+	} else if opts.Namespace != "" {
+		q += " project:" + opts.Namespace
+	}
+	return q
+}
+
+type searchInput struct {
+	Query    string `json:"query"`
+	Entities struct {
+		Code *searchEntity `json:"code,omitempty"`
+	} `json:"entities"`
+	Limits struct {
+		Primary int `json:"primary"`
+	} `json:"limits"`
+}
+
+type searchEntity struct {
+	Start int `json:"start"`
+	Limit int `json:"limit"`
+}
+
+type searchResults struct {
+	Code struct {
+		IsLastPage bool                `json:"isLastPage"`
+		NextStart  int                 `json:"nextStart"`
+		Values     []*codeSearchResult `json:"values"`
+	} `json:"code"`
+}
+
+type codeSearchResult struct {
+	Repository  *repository `json:"repository"`
+	File        string      `json:"file"`
+	HitContexts [][]struct {
+		Line int    `json:"line"`
+		Text string `json:"text"`
+	} `json:"hitContexts"`
+}
+
+func convertCodeResultList(from []*codeSearchResult) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+// helper function converts the code search result. The
+// matching text is html escaped and highlighted using
+// emphasis tags, which are removed.
+func convertCodeResult(from *codeSearchResult) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Path: from.File,
+	}
+	if from.Repository != nil {
+		to.Repo = scm.Join(from.Repository.Project.Key, from.Repository.Slug)
+		to.RepoID = strconv.Itoa(from.Repository.ID)
+	}
+	for _, context := range from.HitContexts {
+		if len(context) == 0 {
+			continue
+		}
+		var lines []string
+		for _, line := range context {
+			text := strings.NewReplacer("<em>", "", "</em>", "").Replace(line.Text)
+			lines = append(lines, html.UnescapeString(text))
+		}
+		to.Fragments = append(to.Fragments, &scm.CodeFragment{
+			Line:    context[0].Line,
+			Content: strings.Join(lines, "\n"),
+		})
+	}
+	return to
+}
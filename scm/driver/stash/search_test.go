@@ -0,0 +1,67 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/search/latest/search").
+		JSON(map[string]interface{}{
+			"query": "github.com/pkg/errors project:PRJ",
+			"entities": map[string]interface{}{
+				"code": map[string]interface{}{"start": 0, "limit": 25},
+			},
+			"limits": map[string]interface{}{"primary": 25},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_code.json")
+
+	opts := scm.SearchOptions{
+		Query:     "github.com/pkg/errors",
+		Namespace: "PRJ",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := os.ReadFile("testdata/search_code.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestSearchRepositories(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Search.Repositories(context.Background(), scm.SearchOptions{Query: "my-repo"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/hmac"
+)
+
+// SimulateWebhook returns a webhook request for the push or
+// pull request hook with the payload, headers and signature
+// that bitbucket server sends, which can be used to test
+// webhook handlers without a connection to bitbucket server.
+// The payload is signed if the secret is not empty.
+// ErrNotSupported is returned for other hook types and
+// actions.
+func SimulateWebhook(target string, hook scm.Webhook, secret string) (*http.Request, error) {
+	var event string
+	var payload interface{}
+	switch v := hook.(type) {
+	case *scm.PushHook:
+		event = "repo:refs_changed"
+		payload = simulatePushHook(v, event)
+	case *scm.PullRequestHook:
+		var ok bool
+		event, ok = simulatePullRequestEvent(v.Action)
+		if !ok {
+			return nil, scm.ErrNotSupported
+		}
+		payload = simulatePullRequestHook(v, event)
+	default:
+		return nil, scm.ErrNotSupported
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "Atlassian HttpClient")
+	req.Header.Set("X-Event-Key", event)
+	if guid := hook.Meta().GUID; guid != "" {
+		req.Header.Set("X-Request-Id", guid)
+	}
+	if secret != "" {
+		req.Header.Set("X-Hub-Signature", hmac.SignPrefix(data, []byte(secret)))
+	}
+	return req, nil
+}
+
+//
+// native payload simulation
+//
+
+func simulatePushHook(src *scm.PushHook, event string) *pushHook {
+	after := src.After
+	if after == "" {
+		after = src.Commit.Sha
+	}
+	dst := &change{
+		RefID:    src.Ref,
+		FromHash: src.Before,
+		ToHash:   after,
+		Type:     "UPDATE",
+	}
+	dst.Ref.ID = src.Ref
+	dst.Ref.DisplayID = scm.TrimRef(src.Ref)
+	dst.Ref.Type = "BRANCH"
+	if scm.IsTag(src.Ref) {
+		dst.Ref.Type = "TAG"
+	}
+	date := src.Commit.Author.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return &pushHook{
+		EventKey:   event,
+		Date:       date.UTC().Format("2006-01-02T15:04:05+0000"),
+		Actor:      simulateUser(&src.Sender),
+		Repository: simulateRepository(&src.Repo),
+		Changes:    []*change{dst},
+	}
+}
+
+func simulatePullRequestHook(src *scm.PullRequestHook, event string) *pullRequestHook {
+	pull := &src.PullRequest
+	dst := &pr{
+		ID:          pull.Number,
+		Title:       pull.Title,
+		Description: pull.Body,
+		State:       "OPEN",
+		Open:        true,
+		CreatedDate: pull.Created.Unix() * 1000,
+		UpdatedDate: pull.Updated.Unix() * 1000,
+	}
+	switch {
+	case pull.Merged || src.Action == scm.ActionMerge:
+		dst.State, dst.Open, dst.Closed = "MERGED", false, true
+	case pull.Closed || src.Action == scm.ActionClose:
+		dst.State, dst.Open, dst.Closed = "DECLINED", false, true
+	}
+	repo := simulateRepository(&src.Repo)
+	fork := *repo
+	if pull.Fork != "" {
+		fork.Project.Key, fork.Slug = scm.Split(pull.Fork)
+		fork.Name = fork.Slug
+	}
+	dst.FromRef.ID = scm.ExpandRef(pull.Source, "refs/heads")
+	dst.FromRef.DisplayID = pull.Source
+	dst.FromRef.LatestCommit = pull.Sha
+	dst.FromRef.Repository = fork
+	dst.ToRef.ID = scm.ExpandRef(pull.Target, "refs/heads")
+	dst.ToRef.DisplayID = pull.Target
+	dst.ToRef.LatestCommit = pull.Base.Sha
+	dst.ToRef.Repository = *repo
+	dst.Author.User.Slug = pull.Author.Login
+	dst.Author.User.Name = pull.Author.Login
+	dst.Author.User.DisplayName = pull.Author.Name
+	dst.Author.User.EmailAddress = pull.Author.Email
+	dst.Author.Role = "AUTHOR"
+	dst.Properties.MergeCommit.ID = pull.Merge
+	if pull.Link != "" {
+		dst.Links.Self = []link{{Href: pull.Link}}
+	}
+	hook := &pullRequestHook{
+		EventKey:    event,
+		Date:        time.Now().UTC().Format("2006-01-02T15:04:05+0000"),
+		Actor:       simulateUser(&src.Sender),
+		PullRequest: dst,
+	}
+	// the target reference is unchanged when the pull
+	// request title or description is modified.
+	hook.PreviousTarget.ID = dst.ToRef.ID
+	hook.PreviousTarget.DisplayID = dst.ToRef.DisplayID
+	hook.PreviousTarget.Type = "BRANCH"
+	hook.PreviousTarget.LatestCommit = dst.ToRef.LatestCommit
+	hook.PreviousTarget.LatestChangeset = dst.ToRef.LatestCommit
+	return hook
+}
+
+func simulateRepository(src *scm.Repository) *repository {
+	id, _ := strconv.Atoi(src.ID)
+	dst := &repository{
+		Slug:     src.Name,
+		ID:       id,
+		Name:     src.Name,
+		ScmID:    "git",
+		State:    "AVAILABLE",
+		Forkable: true,
+		Public:   !src.Private,
+	}
+	dst.Project.Key = src.Namespace
+	dst.Project.Name = src.Namespace
+	dst.Project.Type = "NORMAL"
+	if src.Clone != "" {
+		dst.Links.Clone = append(dst.Links.Clone, link{Href: src.Clone, Name: "http"})
+	}
+	if src.CloneSSH != "" {
+		dst.Links.Clone = append(dst.Links.Clone, link{Href: src.CloneSSH, Name: "ssh"})
+	}
+	if src.Link != "" {
+		dst.Links.Self = []link{{Href: src.Link}}
+	}
+	return dst
+}
+
+func simulateUser(src *scm.User) *user {
+	id, _ := strconv.Atoi(src.ID)
+	return &user{
+		Name:         src.Login,
+		EmailAddress: src.Email,
+		ID:           id,
+		DisplayName:  src.Name,
+		Active:       true,
+		Slug:         src.Login,
+		Type:         "NORMAL",
+	}
+}
+
+// helper function returns the native pull request event.
+func simulatePullRequestEvent(from scm.Action) (string, bool) {
+	switch from {
+	case scm.ActionOpen:
+		return "pr:opened", true
+	case scm.ActionSync:
+		return "pr:from_ref_updated", true
+	case scm.ActionUpdate:
+		return "pr:modified", true
+	case scm.ActionClose:
+		return "pr:declined", true
+	case scm.ActionMerge:
+		return "pr:merged", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var simulateRepo = scm.Repository{
+	ID:        "1",
+	Namespace: "PRJ",
+	Name:      "my-repo",
+	Branch:    "master",
+	Private:   true,
+	Clone:     "http://example.com:7990/scm/prj/my-repo.git",
+	CloneSSH:  "ssh://git@example.com:7999/prj/my-repo.git",
+	Link:      "http://example.com:7990/projects/PRJ/repos/my-repo/browse",
+}
+
+var simulateSender = scm.User{
+	Login:  "jcitizen",
+	Name:   "Jane Citizen",
+	Email:  "jane@example.com",
+	Avatar: "https://www.gravatar.com/avatar/9e26471d35a78862c17e467d87cddedf.jpg",
+}
+
+func TestSimulateWebhook(t *testing.T) {
+	signature := scm.Signature{
+		Name:   "Jane Citizen",
+		Email:  "jane@example.com",
+		Login:  "jcitizen",
+		Avatar: "https://www.gravatar.com/avatar/9e26471d35a78862c17e467d87cddedf.jpg",
+		Date:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	created := time.Unix(1704164645, 0)
+	tests := []scm.Webhook{
+		&scm.PushHook{
+			Ref:    "refs/heads/master",
+			Before: "4522e1bcf8a6ab32f8d7a6bda44cfe0c6f0c6cf7",
+			After:  "ef98532add3b2feb7a137426bba1248724367df5",
+			Commit: scm.Commit{
+				Sha:       "ef98532add3b2feb7a137426bba1248724367df5",
+				Author:    signature,
+				Committer: signature,
+			},
+			Commits: []scm.Commit{
+				{Sha: "ef98532add3b2feb7a137426bba1248724367df5"},
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+		&scm.PullRequestHook{
+			Action: scm.ActionMerge,
+			PullRequest: scm.PullRequest{
+				Number: 1,
+				Title:  "update readme",
+				Body:   "adding build instructions to readme",
+				Sha:    "ef98532add3b2feb7a137426bba1248724367df5",
+				Merge:  "f3a2f0a7d9c1b2e4a5d6c7b8a9f0e1d2c3b4a5f6",
+				Ref:    "refs/pull-requests/1/from",
+				Source: "feature",
+				Target: "master",
+				Fork:   "PRJ/my-repo",
+				Link:   "http://example.com:7990/projects/PRJ/repos/my-repo/pull-requests/1",
+				Closed: true,
+				Merged: true,
+				Head: scm.Reference{
+					Name: "feature",
+					Path: "refs/heads/feature",
+					Sha:  "ef98532add3b2feb7a137426bba1248724367df5",
+				},
+				Base: scm.Reference{
+					Name: "master",
+					Path: "refs/heads/master",
+					Sha:  "4522e1bcf8a6ab32f8d7a6bda44cfe0c6f0c6cf7",
+				},
+				Author:  simulateSender,
+				Created: created,
+				Updated: created,
+			},
+			Repo:   simulateRepo,
+			Sender: simulateSender,
+		},
+	}
+	secret, _ := secretFunc(nil)
+	for _, want := range tests {
+		req, err := SimulateWebhook("http://localhost/hook", want, secret)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got, err := new(webhookService).Parse(req, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, ignoreMeta); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
+
+func TestSimulateWebhook_SignatureInvalid(t *testing.T) {
+	hook := &scm.PushHook{Ref: "refs/heads/master", Repo: simulateRepo}
+	req, err := SimulateWebhook("http://localhost/hook", hook, "wrongsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = new(webhookService).Parse(req, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
+func TestSimulateWebhook_NotSupported(t *testing.T) {
+	_, err := SimulateWebhook("http://localhost/hook", &scm.TagHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error, got %v", err)
+	}
+	_, err = SimulateWebhook("http://localhost/hook", &scm.PullRequestHook{}, "topsecret")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect not supported error for unknown action, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -82,6 +83,36 @@ func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email,
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("rest/ssh/1.0/keys?%s", encodeListOptions(opts))
+	out := new(keys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Text:  input.Key,
+		Label: input.Title,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "rest/ssh/1.0/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) DeleteKey(ctx context.Context, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("rest/ssh/1.0/keys/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *userService) ListGPGKeys(context.Context, scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type user struct {
 	Name         string `json:"name"`
 	EmailAddress string `json:"emailAddress"`
@@ -101,6 +132,22 @@ type userFilter struct {
 	Values []*user `json:"values"`
 }
 
+type key struct {
+	ID    int    `json:"id"`
+	Text  string `json:"text"`
+	Label string `json:"label"`
+}
+
+type keyInput struct {
+	Text  string `json:"text"`
+	Label string `json:"label,omitempty"`
+}
+
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
 func convertUser(from *user) *scm.User {
 	return &scm.User{
 		Avatar: avatarLink(from.EmailAddress),
@@ -110,6 +157,22 @@ func convertUser(from *user) *scm.User {
 	}
 }
 
+func convertKeyList(from *keys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:    strconv.Itoa(from.ID),
+		Title: from.Label,
+		Key:   from.Text,
+	}
+}
+
 func avatarLink(email string) string {
 	hasher := md5.New()
 	hasher.Write([]byte(strings.ToLower(email)))
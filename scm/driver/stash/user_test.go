@@ -179,3 +179,73 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Want email %s, got %s", want, got)
 	}
 }
+
+func TestUserKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/ssh/1.0/keys").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.ListKeys(context.Background(), scm.ListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Key{}
+	raw, _ := os.ReadFile("testdata/keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/ssh/1.0/keys").
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title: "jcitizen@laptop",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := os.ReadFile("testdata/key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/ssh/1.0/keys/1").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Users.DeleteKey(context.Background(), "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
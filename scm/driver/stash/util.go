@@ -29,6 +29,23 @@ func encodeListOptions(opts scm.ListOptions) string {
 	return params.Encode()
 }
 
+func encodeFileCommitListOptions(path string, opts scm.FileCommitListOptions) string {
+	params := url.Values{}
+	params.Set("path", path)
+	if opts.Ref != "" {
+		params.Set("until", opts.Ref)
+	}
+	if opts.Page > 1 {
+		params.Set("start", strconv.Itoa(
+			(opts.Page-1)*opts.Size),
+		)
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
 func encodeListOptionsV2(opts scm.ListOptions) string {
 	params := url.Values{}
 	limit := defaultLimit
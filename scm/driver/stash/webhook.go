@@ -37,11 +37,28 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Event-Key") {
+	event := req.Header.Get("X-Event-Key")
+	switch event {
 	case "repo:refs_changed":
 		hook, err = s.parsePushHook(data)
 	case "pr:opened", "pr:from_ref_updated", "pr:modified", "pr:declined", "pr:deleted", "pr:merged":
 		hook, err = s.parsePullRequest(data)
+	case "pr:comment:added", "pr:comment:edited", "pr:comment:deleted":
+		hook, err = s.parsePullRequestCommentHook(data)
+	case "pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work", "pr:reviewer:updated":
+		hook, err = s.parseReviewerHook(data)
+	case "repo:comment:added", "repo:comment:edited", "repo:comment:deleted":
+		hook, err = s.parseCommitCommentHook(data)
+	case "repo:forked":
+		hook, err = s.parseForkHook(data)
+	case "repo:modified":
+		hook, err = s.parseRepositoryHook(data)
+	case "mirror:repo_synchronized":
+		hook, err = s.parseMirrorHook(data)
+	default:
+		if s.client != nil && s.client.RawWebhooks {
+			hook, err = s.parseRawHook(req, data)
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -50,6 +67,15 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		return nil, nil
 	}
 
+	// record the webhook delivery metadata, which can be
+	// used to detect redeliveries of the same event.
+	hook.SetMeta(scm.WebhookMeta{
+		Driver:   scm.DriverStash,
+		GUID:     req.Header.Get("X-Request-Id"),
+		Event:    event,
+		Received: time.Now(),
+	})
+
 	// get the gogs signature key to verify the payload
 	// signature. If no key is provided, no validation
 	// is performed.
@@ -68,6 +94,22 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	return hook, nil
 }
 
+func (s *webhookService) parseRawHook(req *http.Request, data []byte) (scm.Webhook, error) {
+	// the repository is extracted on a best effort basis,
+	// since the payload structure of an unrecognized event
+	// is not known.
+	src := new(rawHook)
+	_ = json.Unmarshal(data, src)
+	dst := &scm.RawHook{
+		Header: req.Header.Clone(),
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	return dst, nil
+}
+
 func (s *webhookService) parsePushHook(data []byte) (scm.Webhook, error) {
 	dst := new(pushHook)
 	err := json.Unmarshal(data, dst)
@@ -125,10 +167,112 @@ func (s *webhookService) parsePullRequest(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parsePullRequestCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Comment == nil {
+		return nil, errors.New("Comment hook has empty comment")
+	}
+	dst := convertPullRequestCommentHook(src)
+	switch src.EventKey {
+	case "pr:comment:added":
+		dst.Action = scm.ActionCreate
+	case "pr:comment:edited":
+		dst.Action = scm.ActionEdit
+	case "pr:comment:deleted":
+		dst.Action = scm.ActionDelete
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseReviewerHook(data []byte) (scm.Webhook, error) {
+	src := new(reviewerHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertReviewerHook(src)
+	switch src.EventKey {
+	case "pr:reviewer:approved", "pr:reviewer:needs_work":
+		dst.Action = scm.ActionSubmit
+	case "pr:reviewer:unapproved":
+		dst.Action = scm.ActionDismiss
+	case "pr:reviewer:updated":
+		dst.Action = scm.ActionUpdate
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseCommitCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(commitCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Comment == nil {
+		return nil, errors.New("Comment hook has empty comment")
+	}
+	dst := convertCommitCommentHook(src)
+	switch src.EventKey {
+	case "repo:comment:added":
+		dst.Action = scm.ActionCreate
+	case "repo:comment:edited":
+		dst.Action = scm.ActionEdit
+	case "repo:comment:deleted":
+		dst.Action = scm.ActionDelete
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseForkHook(data []byte) (scm.Webhook, error) {
+	src := new(forkHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Repository.Origin == nil {
+		return nil, errors.New("Fork hook has empty origin repository")
+	}
+	return convertForkHook(src), nil
+}
+
+func (s *webhookService) parseRepositoryHook(data []byte) (scm.Webhook, error) {
+	src := new(repositoryHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	if src.Old == nil || src.New == nil {
+		return nil, errors.New("Repository hook has empty repository")
+	}
+	return convertRepositoryHook(src), nil
+}
+
+// mirror synchronization events use the same payload
+// as push events, without the actor.
+func (s *webhookService) parseMirrorHook(data []byte) (scm.Webhook, error) {
+	dst := new(pushHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	if len(dst.Changes) == 0 {
+		return nil, errors.New("Mirror hook has empty changeset")
+	}
+	return convertPushHook(dst), nil
+}
+
 //
 // native data structures
 //
 
+type rawHook struct {
+	Repository *repository `json:"repository"`
+}
+
 type pushHook struct {
 	EventKey   string      `json:"eventKey"`
 	Date       string      `json:"date"`
@@ -154,6 +298,51 @@ type pullRequestHook struct {
 	} `json:"previousTarget"`
 }
 
+type pullRequestCommentHook struct {
+	EventKey    string              `json:"eventKey"`
+	Date        string              `json:"date"`
+	Actor       *user               `json:"actor"`
+	PullRequest *pr                 `json:"pullRequest"`
+	Comment     *pullRequestComment `json:"comment"`
+}
+
+type reviewerHook struct {
+	EventKey         string       `json:"eventKey"`
+	Date             string       `json:"date"`
+	Actor            *user        `json:"actor"`
+	PullRequest      *pr          `json:"pullRequest"`
+	Participant      *participant `json:"participant"`
+	AddedReviewers   []*user      `json:"addedReviewers"`
+	RemovedReviewers []*user      `json:"removedReviewers"`
+}
+
+type commitCommentHook struct {
+	EventKey   string              `json:"eventKey"`
+	Date       string              `json:"date"`
+	Actor      *user               `json:"actor"`
+	Repository *repository         `json:"repository"`
+	Commit     string              `json:"commit"`
+	Comment    *pullRequestComment `json:"comment"`
+}
+
+type forkHook struct {
+	EventKey   string `json:"eventKey"`
+	Date       string `json:"date"`
+	Actor      *user  `json:"actor"`
+	Repository struct {
+		repository
+		Origin *repository `json:"origin"`
+	} `json:"repository"`
+}
+
+type repositoryHook struct {
+	EventKey string      `json:"eventKey"`
+	Date     string      `json:"date"`
+	Actor    *user       `json:"actor"`
+	Old      *repository `json:"old"`
+	New      *repository `json:"new"`
+}
+
 type change struct {
 	Ref struct {
 		ID        string `json:"id"`
@@ -173,8 +362,14 @@ type change struct {
 func convertPushHook(src *pushHook) *scm.PushHook {
 	change := src.Changes[0]
 	repo := convertRepository(src.Repository)
-	sender := convertUser(src.Actor)
-	signer := convertSignature(src.Actor)
+	sender := new(scm.User)
+	signer := scm.Signature{}
+	// the actor is not included in mirror synchronization
+	// events.
+	if src.Actor != nil {
+		sender = convertUser(src.Actor)
+		signer = convertSignature(src.Actor)
+	}
 	signer.Date, _ = time.Parse("2006-01-02T15:04:05+0000", src.Date)
 
 	var commits []scm.Commit
@@ -266,3 +461,80 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 		Sender:      *sender,
 	}
 }
+
+func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequestCommentHook {
+	repo := convertRepository(&src.PullRequest.ToRef.Repository)
+	pr := convertPullRequest(src.PullRequest)
+	comment := convertPullRequestComment(src.Comment)
+	sender := convertUser(src.Actor)
+
+	return &scm.PullRequestCommentHook{
+		Repo:        *repo,
+		PullRequest: *pr,
+		Comment:     *comment,
+		Sender:      *sender,
+	}
+}
+
+func convertReviewerHook(src *reviewerHook) *scm.ReviewerHook {
+	repo := convertRepository(&src.PullRequest.ToRef.Repository)
+	pr := convertPullRequest(src.PullRequest)
+	sender := convertUser(src.Actor)
+
+	dst := &scm.ReviewerHook{
+		Repo:        *repo,
+		PullRequest: *pr,
+		Sender:      *sender,
+	}
+	if src.Participant != nil {
+		dst.Reviewer = scm.Reviewer{
+			User:  *convertUser(&src.Participant.User),
+			State: convertParticipantState(src.Participant),
+		}
+	}
+	for _, v := range src.AddedReviewers {
+		dst.Added = append(dst.Added, *convertUser(v))
+	}
+	for _, v := range src.RemovedReviewers {
+		dst.Removed = append(dst.Removed, *convertUser(v))
+	}
+	return dst
+}
+
+func convertCommitCommentHook(src *commitCommentHook) *scm.CommitCommentHook {
+	repo := convertRepository(src.Repository)
+	comment := convertPullRequestComment(src.Comment)
+	sender := convertUser(src.Actor)
+
+	return &scm.CommitCommentHook{
+		Repo:    *repo,
+		Sha:     src.Commit,
+		Comment: *comment,
+		Sender:  *sender,
+	}
+}
+
+func convertForkHook(src *forkHook) *scm.ForkHook {
+	repo := convertRepository(src.Repository.Origin)
+	fork := convertRepository(&src.Repository.repository)
+	sender := convertUser(src.Actor)
+
+	return &scm.ForkHook{
+		Repo:   *repo,
+		Fork:   *fork,
+		Sender: *sender,
+	}
+}
+
+func convertRepositoryHook(src *repositoryHook) *scm.RepositoryHook {
+	repo := convertRepository(src.New)
+	prev := convertRepository(src.Old)
+	sender := convertUser(src.Actor)
+
+	return &scm.RepositoryHook{
+		Action:   scm.ActionUpdate,
+		Repo:     *repo,
+		Previous: *prev,
+		Sender:   *sender,
+	}
+}
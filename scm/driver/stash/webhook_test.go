@@ -14,6 +14,7 @@ import (
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestWebhooks(t *testing.T) {
@@ -146,6 +147,102 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_deleted.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request comment added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:added",
+			before: "testdata/webhooks/pr_comment_added.json",
+			after:  "testdata/webhooks/pr_comment_added.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request comment edited
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:edited",
+			before: "testdata/webhooks/pr_comment_edited.json",
+			after:  "testdata/webhooks/pr_comment_edited.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request comment deleted
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:deleted",
+			before: "testdata/webhooks/pr_comment_deleted.json",
+			after:  "testdata/webhooks/pr_comment_deleted.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request reviewer approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:approved",
+			before: "testdata/webhooks/pr_reviewer_approved.json",
+			after:  "testdata/webhooks/pr_reviewer_approved.json.golden",
+			obj:    new(scm.ReviewerHook),
+		},
+		// pull request reviewer needs work
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:needs_work",
+			before: "testdata/webhooks/pr_reviewer_needs_work.json",
+			after:  "testdata/webhooks/pr_reviewer_needs_work.json.golden",
+			obj:    new(scm.ReviewerHook),
+		},
+		// pull request reviewer unapproved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:unapproved",
+			before: "testdata/webhooks/pr_reviewer_unapproved.json",
+			after:  "testdata/webhooks/pr_reviewer_unapproved.json.golden",
+			obj:    new(scm.ReviewerHook),
+		},
+		// pull request reviewers updated
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:updated",
+			before: "testdata/webhooks/pr_reviewer_updated.json",
+			after:  "testdata/webhooks/pr_reviewer_updated.json.golden",
+			obj:    new(scm.ReviewerHook),
+		},
+		// commit comment added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:comment:added",
+			before: "testdata/webhooks/repo_comment_added.json",
+			after:  "testdata/webhooks/repo_comment_added.json.golden",
+			obj:    new(scm.CommitCommentHook),
+		},
+		// repository forked
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:forked",
+			before: "testdata/webhooks/repo_forked.json",
+			after:  "testdata/webhooks/repo_forked.json.golden",
+			obj:    new(scm.ForkHook),
+		},
+		// repository modified
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:modified",
+			before: "testdata/webhooks/repo_modified.json",
+			after:  "testdata/webhooks/repo_modified.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// project hook, repository moved to another project
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:modified",
+			before: "testdata/webhooks/project_repo_modified.json",
+			after:  "testdata/webhooks/project_repo_modified.json.golden",
+			obj:    new(scm.RepositoryHook),
+		},
+		// mirror synchronized
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "mirror:repo_synchronized",
+			before: "testdata/webhooks/mirror_repo_synchronized.json",
+			after:  "testdata/webhooks/mirror_repo_synchronized.json.golden",
+			obj:    new(scm.PushHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -177,7 +274,7 @@ func TestWebhooks(t *testing.T) {
 			continue
 		}
 
-		if diff := cmp.Diff(test.obj, o); diff != "" {
+		if diff := cmp.Diff(test.obj, o, ignoreMeta); diff != "" {
 			t.Errorf("Error unmarshaling %s", test.before)
 			t.Log(diff)
 
@@ -228,6 +325,85 @@ func TestWebhookVerified(t *testing.T) {
 	}
 }
 
+func TestWebhook_RawHook(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:secret_detected")
+	r.Header.Set("X-Request-Id", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{RawWebhooks: true}}}
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Meta().Driver, scm.DriverStash; got != want {
+		t.Errorf("Want hook driver %v, got %v", want, got)
+	}
+	if got, want := hook.Meta().Event, "repo:secret_detected"; got != want {
+		t.Errorf("Want hook event %q, got %q", want, got)
+	}
+	if got, want := hook.Meta().GUID, "ee8d97b4-1479-43f1-9cac-fbbd1b80da55"; got != want {
+		t.Errorf("Want hook guid %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "my-repo"; got != want {
+		t.Errorf("Want hook repository %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw hook data to match the request body")
+	}
+}
+
+func TestWebhook_RawHookDisabled(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:secret_detected")
+
+	s := &webhookService{client: &wrapper{Client: &scm.Client{}}}
+	o, err := s.Parse(r, secretFunc)
+	if o != nil || err != nil {
+		t.Errorf("Expect nil hook and nil error when raw webhooks are disabled, got %v", err)
+	}
+}
+
+func TestWebhook_Meta(t *testing.T) {
+	f, _ := os.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("POST", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:refs_changed")
+	r.Header.Set("X-Request-Id", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := scm.WebhookMeta{
+		Driver: scm.DriverStash,
+		GUID:   "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:  "repo:refs_changed",
+	}
+	got := o.Meta()
+	if got.Received.IsZero() {
+		t.Errorf("Want webhook received time")
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scm.WebhookMeta{}, "Received")); diff != "" {
+		t.Errorf("Unexpected webhook metadata")
+		t.Log(diff)
+	}
+}
+
+// ignoreMeta ignores the webhook delivery metadata, which
+// is not included in the golden files.
+var ignoreMeta = cmpopts.IgnoreTypes(scm.WebhookMeta{})
+
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/github"
@@ -711,3 +712,35 @@ func ExampleWebhook_lookupSecret() {
 	http.HandleFunc("/hook", handler)
 	http.ListenAndServe(":8000", nil)
 }
+
+func ExampleWebhook_dedupe() {
+	client := github.NewDefault()
+
+	secret := func(webhook scm.Webhook) (string, error) {
+		return "topsecret", nil
+	}
+
+	// record deliveries for one hour to drop redeliveries
+	// of the same event.
+	deduper := scm.NewMemoryDeduper(time.Hour)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		webhook, err := client.Webhooks.Parse(r, secret)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if deduper.Seen(webhook) {
+			log.Println("duplicate delivery", webhook.Meta().GUID)
+			return
+		}
+		log.Println(
+			webhook.Meta().Event,
+			webhook.Meta().GUID,
+			webhook.Repository().Name,
+		)
+	}
+
+	http.HandleFunc("/hook", handler)
+	http.ListenAndServe(":8000", nil)
+}
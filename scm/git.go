@@ -26,6 +26,32 @@ type (
 		Sha  string
 	}
 
+	// Tag represents a git tag. Message and Tagger are
+	// only set for annotated tags.
+	Tag struct {
+		Name    string
+		Path    string
+		Message string
+		Tagger  Signature
+
+		// Object is the sha of the annotated tag object,
+		// and is empty for lightweight tags.
+		Object string
+
+		// Sha is the sha of the commit the tag points to,
+		// after peeling any annotated tag objects.
+		Sha string
+	}
+
+	// TagInput provides the input fields required for
+	// creating a git tag. An annotated tag is created
+	// when the message is not empty.
+	TagInput struct {
+		Name    string
+		Sha     string
+		Message string
+	}
+
 	// Commit represents a repository commit.
 	Commit struct {
 		Sha       string
@@ -33,6 +59,10 @@ type (
 		Author    Signature
 		Committer Signature
 		Link      string
+
+		// Verification is optional. The provider may choose
+		// to include the signature verification in the response.
+		Verification *Verification
 	}
 
 	// CommitListOptions provides options for querying a
@@ -44,6 +74,28 @@ type (
 		Path string
 	}
 
+	// FileCommitListOptions provides options for querying
+	// the list of commits that modified a file.
+	FileCommitListOptions struct {
+		Ref   string
+		Since time.Time
+		Until time.Time
+		Page  int
+		Size  int
+	}
+
+	// Comparison represents the comparison of a head commit
+	// with a base commit. Ahead is the number of commits in
+	// the head that are not in the base, and Behind is the
+	// number of commits in the base that are not in the head.
+	Comparison struct {
+		MergeBase string
+		Ahead     int
+		Behind    int
+		Commits   []*Commit
+		Changes   []*Change
+	}
+
 	// Signature identifies a git commit creator.
 	Signature struct {
 		Name  string
@@ -56,20 +108,46 @@ type (
 		Avatar string
 	}
 
+	// Verification represents the verification of a commit
+	// signature. The Type is the signature format, such as
+	// gpg, ssh or x509, and is empty for unsigned commits.
+	Verification struct {
+		Verified bool
+		Reason   string
+		Signer   string
+		Type     string
+	}
+
 	// GitService provides access to git resources.
 	GitService interface {
 		// CreateBranch creates a git branch by name given a sha.
 		CreateBranch(ctx context.Context, repo string, params *ReferenceInput) (*Response, error)
 
+		// CreateTag creates a git tag by name given a sha.
+		CreateTag(ctx context.Context, repo string, params *TagInput) (*Tag, *Response, error)
+
+		// DeleteBranch deletes a git branch by name.
+		DeleteBranch(ctx context.Context, repo, name string) (*Response, error)
+
+		// DeleteTag deletes a git tag by name.
+		DeleteTag(ctx context.Context, repo, name string) (*Response, error)
+
 		// FindBranch finds a git branch by name.
 		FindBranch(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
 		// FindCommit finds a git commit by ref.
 		FindCommit(ctx context.Context, repo, ref string) (*Commit, *Response, error)
 
+		// FindCommitDiff returns the unified diff of a commit.
+		FindCommitDiff(ctx context.Context, repo, ref string) (*Diff, *Response, error)
+
 		// FindTag finds a git tag by name.
 		FindTag(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
+		// FindTagDetails finds a git tag by name, including
+		// the annotated tag message and tagger.
+		FindTagDetails(ctx context.Context, repo, name string) (*Tag, *Response, error)
+
 		// ListBranches returns a list of git branches.
 		ListBranches(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
@@ -79,12 +157,30 @@ type (
 		// ListCommits returns a list of git commits.
 		ListCommits(ctx context.Context, repo string, opts CommitListOptions) ([]*Commit, *Response, error)
 
+		// ListFileCommits returns the list of commits that
+		// modified the file at the given path.
+		ListFileCommits(ctx context.Context, repo, path string, opts FileCommitListOptions) ([]*Commit, *Response, error)
+
 		// ListChanges returns the changeset of a commit.
 		ListChanges(ctx context.Context, repo, ref string, opts ListOptions) ([]*Change, *Response, error)
 
 		// ListTags returns a list of git tags.
 		ListTags(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
+		// Compare compares the head commit with the base
+		// commit, returning the merge base, the ahead and
+		// behind counts, the commits in the head that are not
+		// in the base and the changeset.
+		Compare(ctx context.Context, repo, base, head string) (*Comparison, *Response, error)
+
+		// FindMergeBase returns the best common ancestor of
+		// the two commits.
+		FindMergeBase(ctx context.Context, repo, base, head string) (*Commit, *Response, error)
+
+		// IsAncestor returns true if the ancestor commit is
+		// reachable from the descendant commit.
+		IsAncestor(ctx context.Context, repo, ancestor, descendant string) (bool, *Response, error)
+
 		// CompareChanges returns the changeset between two
 		// commits. If the source commit is not an ancestor
 		// of the target commit, it is up to the driver to
@@ -26,19 +26,28 @@ type (
 	}
 
 	// IssueInput provides the input fields required for
-	// creating or updating an issue.
+	// creating or updating an issue. When updating an issue,
+	// zero value fields are left unchanged.
 	IssueInput struct {
-		Title string
-		Body  string
+		Title     string
+		Body      string
+		Labels    []string
+		Assignees []string
+		Milestone int
 	}
 
 	// IssueListOptions provides options for querying a
 	// list of repository issues.
 	IssueListOptions struct {
-		Page   int
-		Size   int
-		Open   bool
-		Closed bool
+		Page     int
+		Size     int
+		Open     bool
+		Closed   bool
+		Labels   []string
+		Assignee string
+		Author   string
+		Since    time.Time
+		Sort     IssueSort
 	}
 
 	// Comment represents a comment.
@@ -73,6 +82,9 @@ type (
 		// Create creates a new issue.
 		Create(context.Context, string, *IssueInput) (*Issue, *Response, error)
 
+		// Update updates an issue.
+		Update(context.Context, string, int, *IssueInput) (*Issue, *Response, error)
+
 		// CreateComment creates a new issue comment.
 		CreateComment(context.Context, string, int, *CommentInput) (*Comment, *Response, error)
 
@@ -82,6 +94,23 @@ type (
 		// Close closes an issue.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens a closed issue.
+		Reopen(context.Context, string, int) (*Response, error)
+
+		// AddLabels adds labels to an issue.
+		AddLabels(context.Context, string, int, []string) (*Response, error)
+
+		// RemoveLabel removes a label from an issue.
+		RemoveLabel(context.Context, string, int, string) (*Response, error)
+
+		// SetAssignees replaces the issue assignees. An empty
+		// list removes all assignees.
+		SetAssignees(context.Context, string, int, []string) (*Response, error)
+
+		// SetMilestone sets the issue milestone. A zero
+		// milestone removes the issue from its milestone.
+		SetMilestone(context.Context, string, int, int) (*Response, error)
+
 		// Lock locks an issue discussion.
 		Lock(context.Context, string, int) (*Response, error)
 
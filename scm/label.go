@@ -0,0 +1,51 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// LabelInput provides the input fields required for
+	// creating or updating a repository label. The color
+	// is a hex color code without the leading hash.
+	LabelInput struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
+	// LabelService provides access to repository labels.
+	// Labels are identified by name.
+	LabelService interface {
+		// Find returns the repository label by name.
+		Find(context.Context, string, string) (*Label, *Response, error)
+
+		// List returns the repository label list.
+		List(context.Context, string, ListOptions) ([]*Label, *Response, error)
+
+		// Create creates a new repository label.
+		Create(context.Context, string, *LabelInput) (*Label, *Response, error)
+
+		// Update updates the repository label. Zero value
+		// fields are left unchanged.
+		Update(context.Context, string, string, *LabelInput) (*Label, *Response, error)
+
+		// Delete deletes the repository label.
+		Delete(context.Context, string, string) (*Response, error)
+
+		// AddToIssue adds the label to an issue.
+		AddToIssue(context.Context, string, int, string) (*Response, error)
+
+		// RemoveFromIssue removes the label from an issue.
+		RemoveFromIssue(context.Context, string, int, string) (*Response, error)
+
+		// AddToPullRequest adds the label to a pull request.
+		AddToPullRequest(context.Context, string, int, string) (*Response, error)
+
+		// RemoveFromPullRequest removes the label from a
+		// pull request.
+		RemoveFromPullRequest(context.Context, string, int, string) (*Response, error)
+	}
+)
@@ -0,0 +1,154 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownWebhookType is returned when the webhook type
+// cannot be marshaled or unmarshaled.
+var ErrUnknownWebhookType = errors.New("Unknown webhook type")
+
+// WebhookVersion is the version of the webhook envelope
+// schema written by MarshalWebhook.
+const WebhookVersion = 1
+
+// webhookEnvelope is the type-tagged envelope used to
+// serialize webhooks.
+type webhookEnvelope struct {
+	Type    string          `json:"type"`
+	Version int             `json:"version"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// webhookTypes maps the envelope type to a function that
+// returns a new webhook of that type. The type names are
+// part of the envelope schema and must not be changed.
+var webhookTypes = map[string]func() Webhook{
+	"push":                 func() Webhook { return new(PushHook) },
+	"branch":               func() Webhook { return new(BranchHook) },
+	"deploy":               func() Webhook { return new(DeployHook) },
+	"tag":                  func() Webhook { return new(TagHook) },
+	"issue":                func() Webhook { return new(IssueHook) },
+	"issue_comment":        func() Webhook { return new(IssueCommentHook) },
+	"pull_request":         func() Webhook { return new(PullRequestHook) },
+	"pull_request_comment": func() Webhook { return new(PullRequestCommentHook) },
+	"review_comment":       func() Webhook { return new(ReviewCommentHook) },
+	"release":              func() Webhook { return new(ReleaseHook) },
+	"review":               func() Webhook { return new(ReviewHook) },
+	"status":               func() Webhook { return new(StatusHook) },
+	"check_run":            func() Webhook { return new(CheckRunHook) },
+	"check_suite":          func() Webhook { return new(CheckSuiteHook) },
+	"workflow_run":         func() Webhook { return new(WorkflowRunHook) },
+	"ping":                 func() Webhook { return new(PingHook) },
+	"wiki":                 func() Webhook { return new(WikiHook) },
+	"feature_flag":         func() Webhook { return new(FeatureFlagHook) },
+	"system":               func() Webhook { return new(SystemHook) },
+	"reviewer":             func() Webhook { return new(ReviewerHook) },
+	"commit_comment":       func() Webhook { return new(CommitCommentHook) },
+	"fork":                 func() Webhook { return new(ForkHook) },
+	"repository":           func() Webhook { return new(RepositoryHook) },
+	"raw":                  func() Webhook { return new(RawHook) },
+}
+
+// MarshalWebhook returns the JSON encoding of the webhook,
+// wrapped in an envelope that identifies the webhook type,
+// so that it can be decoded with UnmarshalWebhook.
+func MarshalWebhook(hook Webhook) ([]byte, error) {
+	typ := webhookType(hook)
+	if typ == "" {
+		return nil, ErrUnknownWebhookType
+	}
+	payload, err := json.Marshal(hook)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&webhookEnvelope{
+		Type:    typ,
+		Version: WebhookVersion,
+		Payload: payload,
+	})
+}
+
+// UnmarshalWebhook parses the JSON encoding of a webhook
+// created by MarshalWebhook. Note that the DeployHook data
+// is decoded as generic JSON values.
+func UnmarshalWebhook(data []byte) (Webhook, error) {
+	envelope := new(webhookEnvelope)
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Version > WebhookVersion {
+		return nil, fmt.Errorf("scm: unsupported webhook version %d", envelope.Version)
+	}
+	fn, ok := webhookTypes[envelope.Type]
+	if !ok {
+		return nil, ErrUnknownWebhookType
+	}
+	hook := fn()
+	if err := json.Unmarshal(envelope.Payload, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// helper function returns the envelope type of the webhook.
+func webhookType(hook Webhook) string {
+	switch hook.(type) {
+	case *PushHook:
+		return "push"
+	case *BranchHook:
+		return "branch"
+	case *DeployHook:
+		return "deploy"
+	case *TagHook:
+		return "tag"
+	case *IssueHook:
+		return "issue"
+	case *IssueCommentHook:
+		return "issue_comment"
+	case *PullRequestHook:
+		return "pull_request"
+	case *PullRequestCommentHook:
+		return "pull_request_comment"
+	case *ReviewCommentHook:
+		return "review_comment"
+	case *ReleaseHook:
+		return "release"
+	case *ReviewHook:
+		return "review"
+	case *StatusHook:
+		return "status"
+	case *CheckRunHook:
+		return "check_run"
+	case *CheckSuiteHook:
+		return "check_suite"
+	case *WorkflowRunHook:
+		return "workflow_run"
+	case *PingHook:
+		return "ping"
+	case *WikiHook:
+		return "wiki"
+	case *FeatureFlagHook:
+		return "feature_flag"
+	case *SystemHook:
+		return "system"
+	case *ReviewerHook:
+		return "reviewer"
+	case *CommitCommentHook:
+		return "commit_comment"
+	case *ForkHook:
+		return "fork"
+	case *RepositoryHook:
+		return "repository"
+	case *RawHook:
+		return "raw"
+	default:
+		return ""
+	}
+}
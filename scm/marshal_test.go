@@ -0,0 +1,398 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	mockTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mockUser = User{
+		ID:      "1",
+		Login:   "octocat",
+		Name:    "The Octocat",
+		Email:   "octocat@github.com",
+		Avatar:  "https://github.com/images/error/octocat_happy.gif",
+		Created: mockTime,
+		Updated: mockTime,
+	}
+
+	mockRepo = Repository{
+		ID:         "1296269",
+		Namespace:  "octocat",
+		Name:       "hello-world",
+		Perm:       &Perm{Pull: true, Push: true},
+		Branch:     "master",
+		Visibility: VisibilityPublic,
+		Clone:      "https://github.com/octocat/hello-world.git",
+		CloneSSH:   "git@github.com:octocat/hello-world.git",
+		Link:       "https://github.com/octocat/hello-world",
+		Created:    mockTime,
+		Updated:    mockTime,
+		Language:   RepoLanguages{"Go": 100},
+	}
+
+	mockPullRequest = PullRequest{
+		Number:  1347,
+		Title:   "new-feature",
+		Body:    "Please pull these awesome changes",
+		Sha:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Ref:     "refs/pull/1347/head",
+		Source:  "new-topic",
+		Target:  "master",
+		Link:    "https://github.com/octocat/hello-world/pull/1347",
+		Base:    Reference{Name: "master", Path: "refs/heads/master", Sha: "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e"},
+		Head:    Reference{Name: "new-topic", Path: "refs/heads/new-topic", Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Author:  mockUser,
+		Labels:  []Label{{Name: "bug", Color: "f29513"}},
+		Created: mockTime,
+		Updated: mockTime,
+	}
+
+	mockComment = Comment{
+		ID:      1,
+		Body:    "Me too",
+		Author:  mockUser,
+		Created: mockTime,
+		Updated: mockTime,
+	}
+
+	mockMeta = WebhookMeta{
+		Driver:   DriverGithub,
+		GUID:     "ee8d97b4-1479-43f1-9cac-fbbd1b80da55",
+		Event:    "push",
+		Received: mockTime,
+	}
+)
+
+var mockWebhooks = map[string]Webhook{
+	"push": &PushHook{
+		Ref:      "refs/heads/master",
+		Repo:     mockRepo,
+		Before:   "553c2077f0edc3d5dc5d17262f6aa498e69d6f8e",
+		After:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Commit:   Commit{Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e", Message: "Update README.md", Author: Signature{Name: "The Octocat", Email: "octocat@github.com", Date: mockTime}},
+		Sender:   mockUser,
+		Commits:  []Commit{{Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e", Message: "Update README.md", Verification: &Verification{Verified: true, Reason: "valid"}}},
+		Metadata: mockMeta,
+	},
+	"branch": &BranchHook{
+		Ref:      Reference{Name: "feature", Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Repo:     mockRepo,
+		Action:   ActionCreate,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"deploy": &DeployHook{
+		Data:      map[string]interface{}{"environment": "production"},
+		Desc:      "Deploy to production",
+		Number:    1,
+		Ref:       Reference{Name: "master", Path: "refs/heads/master", Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Repo:      mockRepo,
+		Sender:    mockUser,
+		State:     StateSuccess,
+		Target:    "production",
+		TargetURL: "https://example.com/deploy/1",
+		Task:      "deploy",
+		Metadata:  mockMeta,
+	},
+	"tag": &TagHook{
+		Ref:      Reference{Name: "v1.0.0", Sha: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Repo:     mockRepo,
+		Action:   ActionDelete,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"issue": &IssueHook{
+		Action: ActionOpen,
+		Repo:   mockRepo,
+		Issue: Issue{
+			Number:  1347,
+			Title:   "Found a bug",
+			Body:    "I'm having a problem with this.",
+			Link:    "https://github.com/octocat/hello-world/issues/1347",
+			Labels:  []string{"bug"},
+			Author:  mockUser,
+			Created: mockTime,
+			Updated: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"issue_comment": &IssueCommentHook{
+		Action:   ActionEdit,
+		Repo:     mockRepo,
+		Issue:    Issue{Number: 1347, Title: "Found a bug", Author: mockUser},
+		Comment:  mockComment,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"pull_request": &PullRequestHook{
+		Action:      ActionSync,
+		Repo:        mockRepo,
+		PullRequest: mockPullRequest,
+		Sender:      mockUser,
+		Metadata:    mockMeta,
+	},
+	"pull_request_comment": &PullRequestCommentHook{
+		Action:      ActionCreate,
+		Repo:        mockRepo,
+		PullRequest: mockPullRequest,
+		Comment:     mockComment,
+		Sender:      mockUser,
+		Metadata:    mockMeta,
+	},
+	"review_comment": &ReviewCommentHook{
+		Action:      ActionCreate,
+		Repo:        mockRepo,
+		PullRequest: mockPullRequest,
+		Review: Review{
+			ID:      10,
+			Body:    "Great stuff!",
+			Path:    "file1.txt",
+			Sha:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Line:    1,
+			Side:    DiffSideBase,
+			Author:  mockUser,
+			Created: mockTime,
+			Updated: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"release": &ReleaseHook{
+		Action: ActionPublish,
+		Release: Release{
+			ID:         1,
+			Title:      "v1.0.0",
+			Link:       "https://github.com/octocat/hello-world/releases/v1.0.0",
+			Tag:        "v1.0.0",
+			Commitish:  "master",
+			Prerelease: true,
+			Created:    mockTime,
+			Published:  mockTime,
+		},
+		Repo:     mockRepo,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"review": &ReviewHook{
+		Action:      ActionSubmit,
+		Repo:        mockRepo,
+		PullRequest: mockPullRequest,
+		Review: ReviewSubmission{
+			ID:        80,
+			Body:      "Looks great!",
+			State:     ReviewStateApproved,
+			Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Author:    mockUser,
+			Submitted: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"status": &StatusHook{
+		Sha:      "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Status:   Status{State: StateFailure, Label: "continuous-integration/drone", Desc: "Build failed", Target: "https://example.com/builds/1"},
+		Branches: []string{"master"},
+		Repo:     mockRepo,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"check_run": &CheckRunHook{
+		Action: ActionComplete,
+		Repo:   mockRepo,
+		CheckRun: CheckRun{
+			ID:        4,
+			Name:      "test",
+			Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			State:     StateCanceled,
+			Link:      "https://example.com/checks/4",
+			Started:   mockTime,
+			Completed: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"check_suite": &CheckSuiteHook{
+		Action: ActionRequest,
+		Repo:   mockRepo,
+		CheckSuite: CheckSuite{
+			ID:      5,
+			Sha:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Branch:  "master",
+			State:   StatePending,
+			Created: mockTime,
+			Updated: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"workflow_run": &WorkflowRunHook{
+		Action: ActionStart,
+		Repo:   mockRepo,
+		WorkflowRun: WorkflowRun{
+			ID:      30433642,
+			Name:    "Build",
+			Number:  562,
+			Event:   "push",
+			Sha:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			Branch:  "master",
+			State:   StateRunning,
+			Link:    "https://github.com/octocat/hello-world/actions/runs/30433642",
+			Created: mockTime,
+			Updated: mockTime,
+		},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"ping": &PingHook{
+		HookID:   "30",
+		Zen:      "Non-blocking is better than blocking.",
+		Repo:     mockRepo,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"wiki": &WikiHook{
+		Action:   ActionUpdate,
+		Repo:     mockRepo,
+		Page:     WikiPage{Title: "Home", Slug: "home", Content: "Welcome", Link: "https://github.com/octocat/hello-world/wiki/Home"},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"feature_flag": &FeatureFlagHook{
+		Action:      ActionUpdate,
+		Repo:        mockRepo,
+		FeatureFlag: FeatureFlag{ID: 6, Name: "new-ui", Active: true},
+		Sender:      mockUser,
+		Metadata:    mockMeta,
+	},
+	"system": &SystemHook{
+		Event:    "project_create",
+		Action:   ActionCreate,
+		Repo:     mockRepo,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"reviewer": &ReviewerHook{
+		Action:      ActionUpdate,
+		Repo:        mockRepo,
+		PullRequest: mockPullRequest,
+		Reviewer:    Reviewer{User: mockUser, State: ReviewStateChangesRequested, Required: true},
+		Added:       []User{mockUser},
+		Sender:      mockUser,
+		Metadata:    mockMeta,
+	},
+	"commit_comment": &CommitCommentHook{
+		Action:   ActionCreate,
+		Repo:     mockRepo,
+		Sha:      "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Comment:  mockComment,
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"fork": &ForkHook{
+		Repo:     mockRepo,
+		Fork:     Repository{ID: "1296270", Namespace: "spaceghost", Name: "hello-world", Visibility: VisibilityPrivate, Private: true},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"repository": &RepositoryHook{
+		Action:   ActionUpdate,
+		Repo:     mockRepo,
+		Previous: Repository{ID: "1296269", Namespace: "octocat", Name: "hello", Visibility: VisibilityInternal},
+		Sender:   mockUser,
+		Metadata: mockMeta,
+	},
+	"raw": &RawHook{
+		Header:   http.Header{"X-Gitlab-Event": {"Emoji Hook"}},
+		Data:     json.RawMessage(`{"object_kind":"emoji"}`),
+		Repo:     mockRepo,
+		Metadata: mockMeta,
+	},
+}
+
+func TestMarshalWebhook(t *testing.T) {
+	for name, hook := range mockWebhooks {
+		t.Run(name, func(t *testing.T) {
+			golden, err := os.ReadFile(filepath.Join("testdata", "webhooks", name+".json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := new(bytes.Buffer)
+			if err := json.Compact(want, golden); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := MarshalWebhook(hook)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want.String(), string(got)); diff != "" {
+				t.Errorf("Unexpected Results")
+				t.Log(diff)
+			}
+
+			out, err := UnmarshalWebhook(want.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(hook, out); diff != "" {
+				t.Errorf("Unexpected Results")
+				t.Log(diff)
+			}
+		})
+	}
+}
+
+func TestMarshalWebhook_Types(t *testing.T) {
+	if got, want := len(mockWebhooks), len(webhookTypes); got != want {
+		t.Errorf("Want %d webhook types tested, got %d", want, got)
+	}
+	for name, fn := range webhookTypes {
+		if got := webhookType(fn()); got != name {
+			t.Errorf("Want webhook type %q, got %q", name, got)
+		}
+	}
+}
+
+func TestUnmarshalWebhook_UnknownType(t *testing.T) {
+	_, err := UnmarshalWebhook([]byte(`{"type":"unknown","version":1,"payload":{}}`))
+	if err != ErrUnknownWebhookType {
+		t.Errorf("Want error %s, got %v", ErrUnknownWebhookType, err)
+	}
+}
+
+func TestUnmarshalWebhook_UnsupportedVersion(t *testing.T) {
+	_, err := UnmarshalWebhook([]byte(`{"type":"push","version":2,"payload":{}}`))
+	if err == nil {
+		t.Errorf("Want error for unsupported webhook version")
+	}
+}
+
+func TestUnmarshalWebhook_LegacyEnums(t *testing.T) {
+	data := []byte(`{"type":"status","version":1,"payload":{"Status":{"State":3},"Repo":{"Visibility":1}}}`)
+	hook, err := UnmarshalWebhook(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := hook.(*StatusHook)
+	if got, want := status.Status.State, StateSuccess; got != want {
+		t.Errorf("Want state %s, got %s", want, got)
+	}
+	if got, want := status.Repo.Visibility, VisibilityPublic; got != want {
+		t.Errorf("Want visibility %s, got %s", want, got)
+	}
+}
@@ -22,6 +22,9 @@ type (
 	}
 
 	// OrganizationService provides access to organization resources.
+	// The organization name is the github or gitea organization,
+	// gitlab group, bitbucket workspace, stash project key or
+	// azure devops project.
 	OrganizationService interface {
 		// Find returns the organization by name.
 		Find(ctx context.Context, name string) (*Organization, *Response, error)
@@ -32,5 +35,22 @@ type (
 
 		// List returns the user organization list.
 		List(ctx context.Context, opts ListOptions) ([]*Organization, *Response, error)
+
+		// FindHook returns an organization hook.
+		FindHook(ctx context.Context, name, id string) (*Hook, *Response, error)
+
+		// ListHooks returns a list of organization hooks.
+		ListHooks(ctx context.Context, name string, opts ListOptions) ([]*Hook, *Response, error)
+
+		// CreateHook creates a new organization hook that
+		// receives events for every repository in the
+		// organization.
+		CreateHook(ctx context.Context, name string, input *HookInput) (*Hook, *Response, error)
+
+		// UpdateHook updates an existing organization hook.
+		UpdateHook(ctx context.Context, name, id string, input *HookInput) (*Hook, *Response, error)
+
+		// DeleteHook deletes an organization hook.
+		DeleteHook(ctx context.Context, name, id string) (*Response, error)
 	}
 )
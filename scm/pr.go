@@ -41,6 +41,21 @@ type (
 		Target string
 	}
 
+	// MergeInput provides the input fields used to merge a
+	// pull request. The provider default is used for any
+	// zero value field.
+	MergeInput struct {
+		Method        MergeMethod
+		CommitTitle   string
+		CommitMessage string
+
+		// Sha is the expected head commit of the pull
+		// request. The merge fails if the head has moved.
+		Sha string
+
+		DeleteSourceBranch bool
+	}
+
 	// PullRequestListOptions provides options for querying
 	// a list of repository merge requests.
 	PullRequestListOptions struct {
@@ -61,9 +76,11 @@ type (
 		PrevFilePath string
 	}
 
+	// Label represents a repository label.
 	Label struct {
-		Name  string
-		Color string
+		Name        string
+		Color       string
+		Description string
 	}
 
 	// Milestone the milestone
@@ -85,6 +102,9 @@ type (
 		// FindComment returns the pull request comment by id.
 		FindComment(context.Context, string, int, int) (*Comment, *Response, error)
 
+		// FindDiff returns the unified diff of the pull request.
+		FindDiff(context.Context, string, int) (*Diff, *Response, error)
+
 		// Find returns the repository pull request list.
 		List(context.Context, string, PullRequestListOptions) ([]*PullRequest, *Response, error)
 
@@ -100,6 +120,11 @@ type (
 		// Merge merges the repository pull request.
 		Merge(context.Context, string, int) (*Response, error)
 
+		// MergeWithOptions merges the repository pull request
+		// using the provided merge options. If the provider
+		// cannot honor an option it returns ErrNotSupported.
+		MergeWithOptions(context.Context, string, int, *MergeInput) (*Response, error)
+
 		// Close closes the repository pull request.
 		Close(context.Context, string, int) (*Response, error)
 
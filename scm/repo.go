@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"net/http"
 	"time"
 )
 
@@ -33,6 +34,31 @@ type (
 		Language     RepoLanguages
 	}
 
+	// RepositoryInput provides the input fields required for
+	// creating or updating a repository. Zero value fields
+	// are left unchanged when updating a repository.
+	RepositoryInput struct {
+		// Namespace is the organization or group that owns
+		// the repository. The authenticated user's account
+		// is used when empty.
+		Namespace   string
+		Name        string
+		Description string
+		Branch      string
+		Visibility  Visibility
+
+		// Archived is only used on update to archive the
+		// repository. Use Unarchive to restore an archived
+		// repository.
+		Archived bool
+
+		// Readme and Gitignore are only used on create to
+		// initialize the repository with a README file and
+		// a named .gitignore template.
+		Readme    bool
+		Gitignore string
+	}
+
 	// Perm represents a user's repository permissions.
 	Perm struct {
 		Pull  bool
@@ -78,6 +104,33 @@ type (
 		Tag                bool
 	}
 
+	// HookDelivery represents a single delivery of a
+	// repository hook event to the hook target. The Request
+	// and Response are only populated when the delivery is
+	// fetched individually.
+	HookDelivery struct {
+		ID         string
+		HookID     string
+		GUID       string
+		Event      string
+		Action     string
+		Target     string
+		Status     int
+		Success    bool
+		Redelivery bool
+		Duration   time.Duration
+		Created    time.Time
+		Request    HookDeliveryMessage
+		Response   HookDeliveryMessage
+	}
+
+	// HookDeliveryMessage represents the headers and body
+	// of a hook delivery request or response.
+	HookDeliveryMessage struct {
+		Header http.Header
+		Body   string
+	}
+
 	// Status represents a commit status.
 	Status struct {
 		State  State
@@ -146,6 +199,39 @@ type (
 
 		// DeleteHook deletes a repository hook.
 		DeleteHook(context.Context, string, string) (*Response, error)
+
+		// ListHookDeliveries returns a list of recent
+		// deliveries for a repository hook. Providers that
+		// do not expose the delivery history in the api,
+		// such as gitea and bitbucket cloud, return
+		// ErrNotSupported.
+		ListHookDeliveries(context.Context, string, string, ListOptions) ([]*HookDelivery, *Response, error)
+
+		// FindHookDelivery returns a repository hook delivery,
+		// including the request and response.
+		FindHookDelivery(context.Context, string, string, string) (*HookDelivery, *Response, error)
+
+		// RedeliverHook redelivers a repository hook delivery.
+		RedeliverHook(context.Context, string, string, string) (*Response, error)
+
+		// Create creates a new repository.
+		Create(context.Context, *RepositoryInput) (*Repository, *Response, error)
+
+		// Fork forks a repository into the given namespace,
+		// or the authenticated user's account when empty.
+		Fork(context.Context, string, string) (*Repository, *Response, error)
+
+		// Update updates the repository settings.
+		Update(context.Context, string, *RepositoryInput) (*Repository, *Response, error)
+
+		// Archive marks the repository as archived.
+		Archive(context.Context, string) (*Response, error)
+
+		// Unarchive restores an archived repository.
+		Unarchive(context.Context, string) (*Response, error)
+
+		// Delete deletes a repository.
+		Delete(context.Context, string) (*Response, error)
 	}
 )
 
@@ -10,26 +10,76 @@ import (
 )
 
 type (
-	// Review represents a review comment.
+	// Review represents a review comment. The StartLine and
+	// EndLine are line numbers in the file, and a multi-line
+	// comment spans from StartLine to EndLine on the given
+	// side of the diff. The Line is the position of the
+	// comment in the diff for github, and the same as the
+	// EndLine for the other providers.
 	Review struct {
-		ID      int
-		Body    string
-		Path    string
-		Sha     string
-		Line    int
-		Link    string
-		Author  User
-		Created time.Time
-		Updated time.Time
+		ID        int
+		Body      string
+		Path      string
+		Sha       string
+		Line      int
+		StartLine int
+		EndLine   int
+		Side      DiffSide
+		Thread    string
+		InReplyTo int
+		Resolved  bool
+		Link      string
+		Author    User
+		Created   time.Time
+		Updated   time.Time
 	}
 
 	// ReviewInput provides the input fields required for
-	// creating a review comment.
+	// creating a review comment. The fields have the same
+	// meaning as the Review fields, and the EndLine takes
+	// precedence over the Line when set. A reply is added
+	// to the thread, or to the comment identified by
+	// InReplyTo, and does not require a path and line.
 	ReviewInput struct {
-		Body string
-		Sha  string
-		Path string
-		Line int
+		Body      string
+		Sha       string
+		Path      string
+		Line      int
+		StartLine int
+		EndLine   int
+		Side      DiffSide
+		Thread    string
+		InReplyTo int
+	}
+
+	// ReviewSubmission represents a submitted pull request
+	// review with an overall state.
+	ReviewSubmission struct {
+		ID        int
+		Body      string
+		State     ReviewState
+		Sha       string
+		Link      string
+		Author    User
+		Submitted time.Time
+	}
+
+	// ReviewSubmitInput provides the input fields required
+	// for submitting a pull request review. The state must
+	// be approved, changes requested or commented.
+	ReviewSubmitInput struct {
+		Body     string
+		State    ReviewState
+		Sha      string
+		Comments []*ReviewInput
+	}
+
+	// Reviewer represents a pull request reviewer and
+	// the reviewer approval state.
+	Reviewer struct {
+		User     User
+		State    ReviewState
+		Required bool
 	}
 
 	// ReviewService provides access to review resources.
@@ -45,5 +95,32 @@ type (
 
 		// Delete deletes a review comment.
 		Delete(context.Context, string, int, int) (*Response, error)
+
+		// Resolve resolves a review thread.
+		Resolve(context.Context, string, int, string) (*Response, error)
+
+		// Unresolve unresolves a review thread.
+		Unresolve(context.Context, string, int, string) (*Response, error)
+
+		// Submit submits a review with an optional batch
+		// of review comments.
+		Submit(context.Context, string, int, *ReviewSubmitInput) (*ReviewSubmission, *Response, error)
+
+		// ListSubmissions returns the submitted review list.
+		ListSubmissions(context.Context, string, int, ListOptions) ([]*ReviewSubmission, *Response, error)
+
+		// Dismiss dismisses a submitted review.
+		Dismiss(context.Context, string, int, int, string) (*Response, error)
+
+		// ListReviewers returns the pull request reviewers
+		// and their approval state.
+		ListReviewers(context.Context, string, int, ListOptions) ([]*Reviewer, *Response, error)
+
+		// RequestReviewers requests a review from the users.
+		RequestReviewers(context.Context, string, int, []string) (*Response, error)
+
+		// RemoveReviewers removes the users from the
+		// requested reviewers.
+		RemoveReviewers(context.Context, string, int, []string) (*Response, error)
 	}
 )
@@ -0,0 +1,57 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// SearchOptions provides options for searching code,
+	// repositories, issues and commits. The search can be
+	// narrowed to a namespace (organization, group, workspace
+	// or project) or to a single repository.
+	SearchOptions struct {
+		Query     string
+		Namespace string
+		Repo      string
+		Page      int
+		Size      int
+	}
+
+	// CodeResult represents a file matching a code search.
+	CodeResult struct {
+		Repo      string
+		RepoID    string
+		Path      string
+		Ref       string
+		Link      string
+		Fragments []*CodeFragment
+	}
+
+	// CodeFragment represents a snippet of matching file
+	// content. Line is the one-based line number of the
+	// first line of the snippet, or zero if the provider
+	// does not report line numbers.
+	CodeFragment struct {
+		Line    int
+		Content string
+	}
+
+	// SearchService provides access to code, repository,
+	// issue and commit search.
+	SearchService interface {
+		// Code returns the files matching the search query.
+		Code(context.Context, SearchOptions) ([]*CodeResult, *Response, error)
+
+		// Repositories returns the repositories matching the
+		// search query.
+		Repositories(context.Context, SearchOptions) ([]*Repository, *Response, error)
+
+		// Issues returns the issues matching the search query.
+		Issues(context.Context, SearchOptions) ([]*Issue, *Response, error)
+
+		// Commits returns the commits matching the search query.
+		Commits(context.Context, SearchOptions) ([]*Commit, *Response, error)
+	}
+)
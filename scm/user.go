@@ -28,6 +28,31 @@ type (
 		Verified bool
 	}
 
+	// Key represents a user ssh public key.
+	Key struct {
+		ID      string
+		Title   string
+		Key     string
+		Created time.Time
+	}
+
+	// KeyInput provides the input fields required for
+	// adding a user ssh public key.
+	KeyInput struct {
+		Title string
+		Key   string
+	}
+
+	// GPGKey represents a user gpg public key.
+	GPGKey struct {
+		ID      string
+		KeyID   string
+		Key     string
+		Emails  []string
+		Created time.Time
+		Expires time.Time
+	}
+
 	// UserService provides access to user account resources.
 	UserService interface {
 		// Find returns the authenticated user.
@@ -41,5 +66,17 @@ type (
 
 		// ListEmail returns the user email list.
 		ListEmail(context.Context, ListOptions) ([]*Email, *Response, error)
+
+		// ListKeys returns the authenticated user ssh key list.
+		ListKeys(context.Context, ListOptions) ([]*Key, *Response, error)
+
+		// CreateKey adds an ssh key to the authenticated user.
+		CreateKey(context.Context, *KeyInput) (*Key, *Response, error)
+
+		// DeleteKey deletes an ssh key from the authenticated user.
+		DeleteKey(context.Context, string) (*Response, error)
+
+		// ListGPGKeys returns the authenticated user gpg key list.
+		ListGPGKeys(context.Context, ListOptions) ([]*GPGKey, *Response, error)
 	}
 )
@@ -89,3 +89,16 @@ func IsPullRequest(ref string) bool {
 func IsHash(s string) bool {
 	return sha1.MatchString(s) || sha256.MatchString(s)
 }
+
+// JoinCommitMessage joins the commit title and message
+// into a single git commit message.
+func JoinCommitMessage(title, message string) string {
+	switch {
+	case title == "":
+		return message
+	case message == "":
+		return title
+	default:
+		return title + "\n\n" + message
+	}
+}
@@ -239,3 +239,19 @@ func TestIsHash(t *testing.T) {
 		}
 	}
 }
+
+func TestJoinCommitMessage(t *testing.T) {
+	tests := []struct {
+		title, message, want string
+	}{
+		{"", "", ""},
+		{"Merge pull request #1", "", "Merge pull request #1"},
+		{"", "Fixed a bug", "Fixed a bug"},
+		{"Merge pull request #1", "Fixed a bug", "Merge pull request #1\n\nFixed a bug"},
+	}
+	for _, test := range tests {
+		if got, want := JoinCommitMessage(test.title, test.message), test.want; got != want {
+			t.Errorf("Got commit message %q, want %q", got, want)
+		}
+	}
+}
@@ -5,8 +5,10 @@
 package scm
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 )
 
 var (
@@ -23,54 +25,77 @@ type (
 	// Webhook defines a webhook for repository events.
 	Webhook interface {
 		Repository() Repository
+
+		// Meta returns the webhook delivery metadata.
+		Meta() WebhookMeta
+
+		// SetMeta sets the webhook delivery metadata.
+		SetMeta(WebhookMeta)
+	}
+
+	// WebhookMeta provides the webhook delivery metadata.
+	// The GUID is the provider-specific delivery identifier,
+	// which is the same across redeliveries of an event, and
+	// is empty if not provided by the driver. The event is
+	// the provider-specific event name.
+	WebhookMeta struct {
+		Driver   Driver
+		GUID     string
+		Event    string
+		Received time.Time
 	}
 
 	// PushHook represents a push hook, eg push events.
 	PushHook struct {
-		Ref     string
-		BaseRef string
-		Repo    Repository
-		Before  string
-		After   string
-		Commit  Commit
-		Sender  User
-		Commits []Commit
+		Ref      string
+		BaseRef  string
+		Repo     Repository
+		Before   string
+		After    string
+		Commit   Commit
+		Sender   User
+		Commits  []Commit
+		Metadata WebhookMeta
 	}
 
 	// BranchHook represents a branch or tag event,
 	// eg create and delete github event types.
 	BranchHook struct {
-		Ref    Reference
-		Repo   Repository
-		Action Action
-		Sender User
+		Ref      Reference
+		Repo     Repository
+		Action   Action
+		Sender   User
+		Metadata WebhookMeta
 	}
 
 	// TagHook represents a tag event, eg create and delete
 	// github event types.
 	TagHook struct {
-		Ref    Reference
-		Repo   Repository
-		Action Action
-		Sender User
+		Ref      Reference
+		Repo     Repository
+		Action   Action
+		Sender   User
+		Metadata WebhookMeta
 	}
 
 	// IssueHook represents an issue event, eg issues.
 	IssueHook struct {
-		Action Action
-		Repo   Repository
-		Issue  Issue
-		Sender User
+		Action   Action
+		Repo     Repository
+		Issue    Issue
+		Sender   User
+		Metadata WebhookMeta
 	}
 
 	// IssueCommentHook represents an issue comment event,
 	// eg issue_comment.
 	IssueCommentHook struct {
-		Action  Action
-		Repo    Repository
-		Issue   Issue
-		Comment Comment
-		Sender  User
+		Action   Action
+		Repo     Repository
+		Issue    Issue
+		Comment  Comment
+		Sender   User
+		Metadata WebhookMeta
 	}
 
 	// PullRequestHook represents an pull request event,
@@ -80,6 +105,7 @@ type (
 		Repo        Repository
 		PullRequest PullRequest
 		Sender      User
+		Metadata    WebhookMeta
 	}
 
 	// PullRequestCommentHook represents an pull request
@@ -90,6 +116,7 @@ type (
 		PullRequest PullRequest
 		Comment     Comment
 		Sender      User
+		Metadata    WebhookMeta
 	}
 
 	// ReviewCommentHook represents a pull request review
@@ -99,6 +126,19 @@ type (
 		Repo        Repository
 		PullRequest PullRequest
 		Review      Review
+		Sender      User
+		Metadata    WebhookMeta
+	}
+
+	// ReviewHook represents a pull request review event,
+	// eg pull_request_review.
+	ReviewHook struct {
+		Action      Action
+		Repo        Repository
+		PullRequest PullRequest
+		Review      ReviewSubmission
+		Sender      User
+		Metadata    WebhookMeta
 	}
 
 	// DeployHook represents a deployment event. This is
@@ -110,18 +150,222 @@ type (
 		Ref       Reference
 		Repo      Repository
 		Sender    User
+		State     State
 		Target    string
 		TargetURL string
 		Task      string
+		Metadata  WebhookMeta
 	}
 
 	// ReleaseHook represents a release event. This is
 	// currently a GitHub-specific event type.
 	ReleaseHook struct {
-		Action  Action
-		Release Release
-		Repo    Repository
-		Sender  User
+		Action   Action
+		Release  Release
+		Repo     Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// ReviewerHook represents a pull request reviewer event,
+	// eg a reviewer approving a pull request, or reviewers
+	// being added to or removed from a pull request.
+	ReviewerHook struct {
+		Action      Action
+		Repo        Repository
+		PullRequest PullRequest
+		Reviewer    Reviewer
+		Added       []User
+		Removed     []User
+		Sender      User
+		Metadata    WebhookMeta
+	}
+
+	// CommitCommentHook represents a commit comment event,
+	// eg bitbucket server repo:comment events.
+	CommitCommentHook struct {
+		Action   Action
+		Repo     Repository
+		Sha      string
+		Comment  Comment
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// ForkHook represents a repository fork event. The
+	// repository is the upstream repository and the fork
+	// is the newly created repository.
+	ForkHook struct {
+		Repo     Repository
+		Fork     Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// RepositoryHook represents a repository event, eg a
+	// repository being created, modified or deleted. The
+	// previous repository is only set when the repository
+	// is modified.
+	RepositoryHook struct {
+		Action   Action
+		Repo     Repository
+		Previous Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// StatusHook represents a commit status event. This is
+	// currently a GitHub-specific event type.
+	StatusHook struct {
+		Sha      string
+		Status   Status
+		Branches []string
+		Repo     Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// CheckRun represents a single check reported against
+	// a commit, eg a github check run.
+	CheckRun struct {
+		ID        int64
+		Name      string
+		Sha       string
+		State     State
+		Link      string
+		Started   time.Time
+		Completed time.Time
+	}
+
+	// CheckRunHook represents a check run event, eg check_run.
+	CheckRunHook struct {
+		Action   Action
+		Repo     Repository
+		CheckRun CheckRun
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// CheckSuite represents the collection of check runs
+	// created by a single integration for a commit.
+	CheckSuite struct {
+		ID      int64
+		Sha     string
+		Branch  string
+		State   State
+		Created time.Time
+		Updated time.Time
+	}
+
+	// CheckSuiteHook represents a check suite event, eg
+	// check_suite.
+	CheckSuiteHook struct {
+		Action     Action
+		Repo       Repository
+		CheckSuite CheckSuite
+		Sender     User
+		Metadata   WebhookMeta
+	}
+
+	// WorkflowRun represents a single run of a workflow,
+	// eg a github actions workflow run.
+	WorkflowRun struct {
+		ID      int64
+		Name    string
+		Number  int
+		Event   string
+		Sha     string
+		Branch  string
+		State   State
+		Link    string
+		Created time.Time
+		Updated time.Time
+	}
+
+	// WorkflowRunHook represents a workflow run event, eg
+	// workflow_run.
+	WorkflowRunHook struct {
+		Action      Action
+		Repo        Repository
+		WorkflowRun WorkflowRun
+		Sender      User
+		Metadata    WebhookMeta
+	}
+
+	// PingHook represents the event sent when a webhook is
+	// first created, eg ping. The repository is empty for
+	// organization webhooks.
+	PingHook struct {
+		HookID   string
+		Zen      string
+		Repo     Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// WikiPage represents a wiki page.
+	WikiPage struct {
+		Title   string
+		Slug    string
+		Content string
+		Message string
+		Link    string
+	}
+
+	// WikiHook represents a wiki page event, eg gitlab
+	// wiki page hooks and gitea wiki events.
+	WikiHook struct {
+		Action   Action
+		Repo     Repository
+		Page     WikiPage
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// FeatureFlag represents a feature flag.
+	FeatureFlag struct {
+		ID     int
+		Name   string
+		Desc   string
+		Active bool
+	}
+
+	// FeatureFlagHook represents a feature flag event. This
+	// is currently a GitLab-specific event type.
+	FeatureFlagHook struct {
+		Action      Action
+		Repo        Repository
+		FeatureFlag FeatureFlag
+		Sender      User
+		Metadata    WebhookMeta
+	}
+
+	// SystemHook represents an instance, organization or
+	// group event that is not scoped to a repository webhook,
+	// eg gitlab system hooks, gitlab group member events or
+	// github organization events. The event is the provider-
+	// specific event name, and the repository is empty for
+	// events that are not related to a repository.
+	SystemHook struct {
+		Event    string
+		Action   Action
+		Repo     Repository
+		Sender   User
+		Metadata WebhookMeta
+	}
+
+	// RawHook represents a webhook event that is not
+	// recognized by the driver. Raw hooks are only returned
+	// when enabled with Client.RawWebhooks, otherwise the
+	// webhook parser returns ErrUnknownEvent. The provider-
+	// specific event name is recorded in the metadata, and
+	// the repository is empty if it cannot be extracted from
+	// the payload.
+	RawHook struct {
+		Header   http.Header
+		Data     json.RawMessage
+		Repo     Repository
+		Metadata WebhookMeta
 	}
 
 	// SecretFunc provides the Webhook parser with the
@@ -150,3 +394,67 @@ func (h *PullRequestHook) Repository() Repository        { return h.Repo }
 func (h *PullRequestCommentHook) Repository() Repository { return h.Repo }
 func (h *ReviewCommentHook) Repository() Repository      { return h.Repo }
 func (h *ReleaseHook) Repository() Repository            { return h.Repo }
+func (h *ReviewHook) Repository() Repository             { return h.Repo }
+func (h *StatusHook) Repository() Repository             { return h.Repo }
+func (h *CheckRunHook) Repository() Repository           { return h.Repo }
+func (h *CheckSuiteHook) Repository() Repository         { return h.Repo }
+func (h *WorkflowRunHook) Repository() Repository        { return h.Repo }
+func (h *PingHook) Repository() Repository               { return h.Repo }
+func (h *WikiHook) Repository() Repository               { return h.Repo }
+func (h *FeatureFlagHook) Repository() Repository        { return h.Repo }
+func (h *SystemHook) Repository() Repository             { return h.Repo }
+func (h *ReviewerHook) Repository() Repository           { return h.Repo }
+func (h *CommitCommentHook) Repository() Repository      { return h.Repo }
+func (h *ForkHook) Repository() Repository               { return h.Repo }
+func (h *RepositoryHook) Repository() Repository         { return h.Repo }
+func (h *RawHook) Repository() Repository                { return h.Repo }
+
+func (h *PushHook) Meta() WebhookMeta               { return h.Metadata }
+func (h *BranchHook) Meta() WebhookMeta             { return h.Metadata }
+func (h *DeployHook) Meta() WebhookMeta             { return h.Metadata }
+func (h *TagHook) Meta() WebhookMeta                { return h.Metadata }
+func (h *IssueHook) Meta() WebhookMeta              { return h.Metadata }
+func (h *IssueCommentHook) Meta() WebhookMeta       { return h.Metadata }
+func (h *PullRequestHook) Meta() WebhookMeta        { return h.Metadata }
+func (h *PullRequestCommentHook) Meta() WebhookMeta { return h.Metadata }
+func (h *ReviewCommentHook) Meta() WebhookMeta      { return h.Metadata }
+func (h *ReleaseHook) Meta() WebhookMeta            { return h.Metadata }
+func (h *ReviewHook) Meta() WebhookMeta             { return h.Metadata }
+func (h *StatusHook) Meta() WebhookMeta             { return h.Metadata }
+func (h *CheckRunHook) Meta() WebhookMeta           { return h.Metadata }
+func (h *CheckSuiteHook) Meta() WebhookMeta         { return h.Metadata }
+func (h *WorkflowRunHook) Meta() WebhookMeta        { return h.Metadata }
+func (h *PingHook) Meta() WebhookMeta               { return h.Metadata }
+func (h *WikiHook) Meta() WebhookMeta               { return h.Metadata }
+func (h *FeatureFlagHook) Meta() WebhookMeta        { return h.Metadata }
+func (h *SystemHook) Meta() WebhookMeta             { return h.Metadata }
+func (h *ReviewerHook) Meta() WebhookMeta           { return h.Metadata }
+func (h *CommitCommentHook) Meta() WebhookMeta      { return h.Metadata }
+func (h *ForkHook) Meta() WebhookMeta               { return h.Metadata }
+func (h *RepositoryHook) Meta() WebhookMeta         { return h.Metadata }
+func (h *RawHook) Meta() WebhookMeta                { return h.Metadata }
+
+func (h *PushHook) SetMeta(m WebhookMeta)               { h.Metadata = m }
+func (h *BranchHook) SetMeta(m WebhookMeta)             { h.Metadata = m }
+func (h *DeployHook) SetMeta(m WebhookMeta)             { h.Metadata = m }
+func (h *TagHook) SetMeta(m WebhookMeta)                { h.Metadata = m }
+func (h *IssueHook) SetMeta(m WebhookMeta)              { h.Metadata = m }
+func (h *IssueCommentHook) SetMeta(m WebhookMeta)       { h.Metadata = m }
+func (h *PullRequestHook) SetMeta(m WebhookMeta)        { h.Metadata = m }
+func (h *PullRequestCommentHook) SetMeta(m WebhookMeta) { h.Metadata = m }
+func (h *ReviewCommentHook) SetMeta(m WebhookMeta)      { h.Metadata = m }
+func (h *ReleaseHook) SetMeta(m WebhookMeta)            { h.Metadata = m }
+func (h *ReviewHook) SetMeta(m WebhookMeta)             { h.Metadata = m }
+func (h *StatusHook) SetMeta(m WebhookMeta)             { h.Metadata = m }
+func (h *CheckRunHook) SetMeta(m WebhookMeta)           { h.Metadata = m }
+func (h *CheckSuiteHook) SetMeta(m WebhookMeta)         { h.Metadata = m }
+func (h *WorkflowRunHook) SetMeta(m WebhookMeta)        { h.Metadata = m }
+func (h *PingHook) SetMeta(m WebhookMeta)               { h.Metadata = m }
+func (h *WikiHook) SetMeta(m WebhookMeta)               { h.Metadata = m }
+func (h *FeatureFlagHook) SetMeta(m WebhookMeta)        { h.Metadata = m }
+func (h *SystemHook) SetMeta(m WebhookMeta)             { h.Metadata = m }
+func (h *ReviewerHook) SetMeta(m WebhookMeta)           { h.Metadata = m }
+func (h *CommitCommentHook) SetMeta(m WebhookMeta)      { h.Metadata = m }
+func (h *ForkHook) SetMeta(m WebhookMeta)               { h.Metadata = m }
+func (h *RepositoryHook) SetMeta(m WebhookMeta)         { h.Metadata = m }
+func (h *RawHook) SetMeta(m WebhookMeta)                { h.Metadata = m }